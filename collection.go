@@ -0,0 +1,66 @@
+package gotabgo
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+// QueryCollections returns one page of the collections on the current site.
+func (t *TabApi) QueryCollections(opts *QueryOptions) (c []model.Collection, page model.Pagination, err error) {
+	url := fmt.Sprintf("%s/collections%s", t.getSiteUrl(), opts.query())
+	tResponse, err := t.send("QueryCollections", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, page, err
+	}
+	if tResponse.Collections != nil {
+		c = tResponse.Collections.Collection
+	}
+
+	return c, tResponse.Pagination, nil
+}
+
+// QueryAllCollections walks every page of QueryCollections.
+func (t *TabApi) QueryAllCollections(opts *QueryOptions) (c []model.Collection, err error) {
+	for {
+		collections, page, err := t.QueryCollections(opts)
+		if err != nil {
+			return nil, err
+		}
+		c = append(c, collections...)
+		if len(collections) == 0 || !page.HasMore() {
+			return c, nil
+		}
+		opts = opts.nextPage(page)
+	}
+}
+
+// QueryCollectionItems returns one page of the content in a collection.
+func (t *TabApi) QueryCollectionItems(id string, opts *QueryOptions) (items []model.CollectionItem, page model.Pagination, err error) {
+	url := fmt.Sprintf("%s/collections/%s/items%s", t.getSiteUrl(), id, opts.query())
+	tResponse, err := t.send("QueryCollectionItems", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, page, err
+	}
+	if tResponse.CollectionItems != nil {
+		items = tResponse.CollectionItems.CollectionItem
+	}
+
+	return items, tResponse.Pagination, nil
+}
+
+// QueryAllCollectionItems walks every page of QueryCollectionItems.
+func (t *TabApi) QueryAllCollectionItems(id string, opts *QueryOptions) (items []model.CollectionItem, err error) {
+	for {
+		pageItems, page, err := t.QueryCollectionItems(id, opts)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, pageItems...)
+		if len(pageItems) == 0 || !page.HasMore() {
+			return items, nil
+		}
+		opts = opts.nextPage(page)
+	}
+}
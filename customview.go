@@ -0,0 +1,94 @@
+package gotabgo
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+// QueryCustomViews returns one page of the custom views on the current site.
+func (t *TabApi) QueryCustomViews(opts *QueryOptions) (cv []model.CustomView, page model.Pagination, err error) {
+	url := fmt.Sprintf("%s/customviews%s", t.getSiteUrl(), opts.query())
+	tResponse, err := t.send("QueryCustomViews", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, page, err
+	}
+	if tResponse.CustomViews != nil {
+		cv = tResponse.CustomViews.CustomView
+	}
+
+	return cv, tResponse.Pagination, nil
+}
+
+// QueryAllCustomViews walks every page of QueryCustomViews.
+func (t *TabApi) QueryAllCustomViews(opts *QueryOptions) (cv []model.CustomView, err error) {
+	for {
+		views, page, err := t.QueryCustomViews(opts)
+		if err != nil {
+			return nil, err
+		}
+		cv = append(cv, views...)
+		if len(views) == 0 || !page.HasMore() {
+			return cv, nil
+		}
+		opts = opts.nextPage(page)
+	}
+}
+
+// ListCustomViewsForView returns the custom views saved on a view.
+func (t *TabApi) ListCustomViewsForView(view *model.View) (cv []model.CustomView, err error) {
+	return t.QueryAllCustomViews(&QueryOptions{Filter: []string{"viewId:eq:" + view.ID}})
+}
+
+// ListCustomViewsForUser returns the custom views owned by a user.
+func (t *TabApi) ListCustomViewsForUser(u *model.User) (cv []model.CustomView, err error) {
+	return t.QueryAllCustomViews(&QueryOptions{Filter: []string{"ownerId:eq:" + u.ID}})
+}
+
+// GetCustomView returns the custom view with the given ID.
+func (t *TabApi) GetCustomView(id string) (cv *model.CustomView, err error) {
+	url := fmt.Sprintf("%s/customviews/%s", t.getSiteUrl(), id)
+	tResponse, err := t.send("GetCustomView", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.CustomView, nil
+}
+
+// UpdateCustomView renames the custom view identified by customView.ID or
+// hands it to a new owner.
+func (t *TabApi) UpdateCustomView(customView model.CustomView) (cv *model.CustomView, err error) {
+	if customView.ID == "" {
+		return nil, errors.New("Custom view ID is required")
+	}
+	url := fmt.Sprintf("%s/customviews/%s", t.getSiteUrl(), customView.ID)
+	update := model.CustomView{Name: customView.Name}
+	if customView.Owner != nil {
+		update.Owner = &model.Owner{ID: customView.Owner.ID}
+	}
+	tsRequest := model.TsRequest{CustomView: &update}
+	tResponse, err := t.send("UpdateCustomView", http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.CustomView, nil
+}
+
+// DeleteCustomView removes a custom view.
+func (t *TabApi) DeleteCustomView(id string) (err error) {
+	url := fmt.Sprintf("%s/customviews/%s", t.getSiteUrl(), id)
+	_, err = t.send("DeleteCustomView", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
+
+// GetCustomViewImage writes a PNG rendering of a custom view to w.
+func (t *TabApi) GetCustomViewImage(id string, w io.Writer) (err error) {
+	url := fmt.Sprintf("%s/customviews/%s/image", t.getSiteUrl(), id)
+	_, err = t.download("GetCustomViewImage", url, w)
+	return
+}
@@ -0,0 +1,81 @@
+package gotabgo
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+func TestCustomViewEndpoints(t *testing.T) {
+	const cv = `<customView id="cv1" name="Mine" shared="false">
+		<view id="v1"/><workbook id="w1"/><owner id="u1"/>
+	</customView>`
+	runEndpointTests(t, []endpointTest{
+		{
+			name: "QueryCustomViews", status: http.StatusOK,
+			resp: `<pagination pageNumber="1" pageSize="1" totalAvailable="2"/><customViews>` + cv + `</customViews>`,
+			call: func(api *TabApi) (string, error) {
+				views, page, err := api.QueryCustomViews(&QueryOptions{PageSize: 1})
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprint(views[0].ID, " ", views[0].View.ID, " ", page.HasMore()), nil
+			},
+			method: http.MethodGet, url: "customviews?pageSize=1", want: "cv1 v1 true",
+		},
+		{
+			name: "ListCustomViewsForView", status: http.StatusOK,
+			resp: `<pagination pageNumber="1" pageSize="100" totalAvailable="1"/><customViews>` + cv + `</customViews>`,
+			call: func(api *TabApi) (string, error) {
+				views, err := api.ListCustomViewsForView(&model.View{ID: "v1"})
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprint(len(views)), nil
+			},
+			method: http.MethodGet, url: "customviews?filter=viewId%3Aeq%3Av1", want: "1",
+		},
+		{
+			name: "GetCustomView", status: http.StatusOK, resp: cv,
+			call: func(api *TabApi) (string, error) {
+				v, err := api.GetCustomView("cv1")
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprint(v.Name, " ", *v.Shared, " ", v.Owner.ID), nil
+			},
+			method: http.MethodGet, url: "customviews/cv1", want: "Mine false u1",
+		},
+		{
+			name: "UpdateCustomView", status: http.StatusOK, resp: cv,
+			call: func(api *TabApi) (string, error) {
+				v, err := api.UpdateCustomView(model.CustomView{ID: "cv1", Name: "Ours", Owner: &model.Owner{ID: "u2", Name: "ignored"}})
+				if err != nil {
+					return "", err
+				}
+				return v.ID, nil
+			},
+			method: http.MethodPut, url: "customviews/cv1",
+			payload: `<customView name="Ours"><owner id="u2"></owner></customView>`, want: "cv1",
+		},
+		{
+			name: "DeleteCustomView", status: http.StatusNoContent,
+			call: func(api *TabApi) (string, error) {
+				return "", api.DeleteCustomView("cv1")
+			},
+			method: http.MethodDelete, url: "customviews/cv1",
+		},
+		{
+			name: "GetCustomViewImage", status: http.StatusOK, resp: "png",
+			call: func(api *TabApi) (string, error) {
+				var img strings.Builder
+				err := api.GetCustomViewImage("cv1", &img)
+				return fmt.Sprint(strings.Contains(img.String(), "png")), err
+			},
+			method: http.MethodGet, url: "customviews/cv1/image", want: "true",
+		},
+	})
+}
@@ -0,0 +1,123 @@
+package gotabgo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/groundfoundation/gotabgo/model"
+	log "github.com/sirupsen/logrus"
+)
+
+// QueryDataAlerts returns one page of the data-driven alerts on the current
+// site. Filter on "ownerName:eq:<name>" to find the alerts of one user.
+func (t *TabApi) QueryDataAlerts(opts *QueryOptions) (a []model.DataAlert, page model.Pagination, err error) {
+	url := fmt.Sprintf("%s/dataAlerts%s", t.getSiteUrl(), opts.query())
+	tResponse, err := t.send("QueryDataAlerts", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, page, err
+	}
+	if tResponse.DataAlerts != nil {
+		a = tResponse.DataAlerts.DataAlert
+	}
+
+	return a, tResponse.Pagination, nil
+}
+
+// QueryDataAlert returns a data-driven alert along with its recipients.
+func (t *TabApi) QueryDataAlert(id string) (a *model.DataAlert, err error) {
+	url := fmt.Sprintf("%s/dataAlerts/%s", t.getSiteUrl(), id)
+	tResponse, err := t.send("QueryDataAlert", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.DataAlert, nil
+}
+
+// AddUserToDataAlert makes a user a recipient of a data-driven alert.
+func (t *TabApi) AddUserToDataAlert(alertID, userID string) (u *model.User, err error) {
+	url := fmt.Sprintf("%s/dataAlerts/%s/users", t.getSiteUrl(), alertID)
+	tsRequest := model.TsRequest{User: &model.User{ID: userID}}
+	tResponse, err := t.send("AddUserToDataAlert", http.MethodPost, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.User, nil
+}
+
+// DeleteUserFromDataAlert stops a user from receiving a data-driven alert.
+func (t *TabApi) DeleteUserFromDataAlert(alertID, userID string) (err error) {
+	url := fmt.Sprintf("%s/dataAlerts/%s/users/%s", t.getSiteUrl(), alertID, userID)
+	_, err = t.send("DeleteUserFromDataAlert", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
+
+// UpdateDataAlert changes the owner, subject, frequency or visibility of the
+// data-driven alert identified by alert.ID.
+func (t *TabApi) UpdateDataAlert(alert model.DataAlert) (a *model.DataAlert, err error) {
+	if alert.ID == "" {
+		return nil, errors.New("Data alert ID is required")
+	}
+	url := fmt.Sprintf("%s/dataAlerts/%s", t.getSiteUrl(), alert.ID)
+	update := model.DataAlert{
+		Subject:   alert.Subject,
+		Frequency: alert.Frequency,
+		Public:    alert.Public,
+	}
+	if alert.Owner != nil {
+		update.Owner = &model.Owner{ID: alert.Owner.ID}
+	}
+	tsRequest := model.TsRequest{DataAlert: &update}
+	tResponse, err := t.send("UpdateDataAlert", http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.DataAlert, nil
+}
+
+// DeleteDataAlert removes a data-driven alert.
+func (t *TabApi) DeleteDataAlert(id string) (err error) {
+	url := fmt.Sprintf("%s/dataAlerts/%s", t.getSiteUrl(), id)
+	_, err = t.send("DeleteDataAlert", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
+
+// TransferDataAlerts hands every data-driven alert owned by one user to
+// another, for example when the owner leaves. It returns the alerts that were
+// moved before any error occurred.
+func (t *TabApi) TransferDataAlerts(fromUserID, toUserID string) (moved []model.DataAlert, err error) {
+	var owned []model.DataAlert
+	var opts *QueryOptions
+	for {
+		alerts, page, err := t.QueryDataAlerts(opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range alerts {
+			if a.Owner != nil && a.Owner.ID == fromUserID {
+				owned = append(owned, a)
+			}
+		}
+		if len(alerts) == 0 || !page.HasMore() {
+			break
+		}
+		opts = opts.nextPage(page)
+	}
+
+	for _, a := range owned {
+		updated, err := t.UpdateDataAlert(model.DataAlert{ID: a.ID, Owner: &model.Owner{ID: toUserID}})
+		if err != nil {
+			return moved, err
+		}
+		log.WithField("method", "TransferDataAlerts").Debugf("moved alert %s", a.ID)
+		if updated == nil {
+			updated = &a
+		}
+		moved = append(moved, *updated)
+	}
+
+	return moved, nil
+}
@@ -0,0 +1,120 @@
+package gotabgo
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+func TestDataAlertEndpoints(t *testing.T) {
+	const alert = `<dataAlert id="a1" subject="Sales dip" frequency="daily" public="false">
+		<owner id="u1"/><view id="v1"/>
+		<recipients><recipient id="u2" lastSent="2024-01-01T00:00:00Z"/></recipients>
+	</dataAlert>`
+	public := true
+	runEndpointTests(t, []endpointTest{
+		{
+			name: "QueryDataAlerts", status: http.StatusOK,
+			resp: `<pagination pageNumber="1" pageSize="100" totalAvailable="2"/><dataAlerts>` + alert + `<dataAlert id="a2"/></dataAlerts>`,
+			call: func(api *TabApi) (string, error) {
+				a, page, err := api.QueryDataAlerts(&QueryOptions{Filter: []string{"ownerName:eq:ann"}})
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprint(len(a), " ", a[0].Owner.ID, " ", page.HasMore()), nil
+			},
+			method: http.MethodGet, url: "dataAlerts?filter=ownerName%3Aeq%3Aann",
+			want: "2 u1 false",
+		},
+		{
+			name: "QueryDataAlert", status: http.StatusOK, resp: alert,
+			call: func(api *TabApi) (string, error) {
+				a, err := api.QueryDataAlert("a1")
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprint(a.Subject, " ", a.Frequency, " ", *a.Public, " ", a.Recipients.Recipient[0].ID), nil
+			},
+			method: http.MethodGet, url: "dataAlerts/a1", want: "Sales dip daily false u2",
+		},
+		{
+			name: "AddUserToDataAlert", status: http.StatusOK, resp: `<user id="u3" name="bob"/>`,
+			call: func(api *TabApi) (string, error) {
+				u, err := api.AddUserToDataAlert("a1", "u3")
+				if err != nil {
+					return "", err
+				}
+				return u.Name, nil
+			},
+			method: http.MethodPost, url: "dataAlerts/a1/users",
+			payload: `<user id="u3"></user>`, want: "bob",
+		},
+		{
+			name: "DeleteUserFromDataAlert", status: http.StatusNoContent,
+			call: func(api *TabApi) (string, error) {
+				return "", api.DeleteUserFromDataAlert("a1", "u3")
+			},
+			method: http.MethodDelete, url: "dataAlerts/a1/users/u3",
+		},
+		{
+			name: "UpdateDataAlert", status: http.StatusOK, resp: alert,
+			call: func(api *TabApi) (string, error) {
+				a, err := api.UpdateDataAlert(model.DataAlert{
+					ID: "a1", Frequency: model.AlertFrequencyWeekly, Public: &public,
+					Owner: &model.Owner{ID: "u4", Name: "ignored"},
+				})
+				if err != nil {
+					return "", err
+				}
+				return a.ID, nil
+			},
+			method: http.MethodPut, url: "dataAlerts/a1",
+			payload: `<dataAlert frequency="weekly" public="true"><owner id="u4"></owner></dataAlert>`,
+			want:    "a1",
+		},
+		{
+			name: "DeleteDataAlert", status: http.StatusNoContent,
+			call: func(api *TabApi) (string, error) {
+				return "", api.DeleteDataAlert("a1")
+			},
+			method: http.MethodDelete, url: "dataAlerts/a1",
+		},
+	})
+}
+
+func TestTransferDataAlerts(t *testing.T) {
+	var updated []string
+	api := newTestApi(t, func(w http.ResponseWriter, r *http.Request) {
+		const base = "/api/3.18/sites/site-1/dataAlerts"
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == base && r.URL.Query().Get("pageNumber") == "":
+			writeXml(w, `<pagination pageNumber="1" pageSize="2" totalAvailable="3"/>
+				<dataAlerts><dataAlert id="a1"><owner id="u1"/></dataAlert><dataAlert id="a2"><owner id="u2"/></dataAlert></dataAlerts>`)
+		case r.Method == http.MethodGet && r.URL.Path == base && r.URL.Query().Get("pageNumber") == "2":
+			writeXml(w, `<pagination pageNumber="2" pageSize="2" totalAvailable="3"/>
+				<dataAlerts><dataAlert id="a3"><owner id="u1"/></dataAlert></dataAlerts>`)
+		case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, base+"/"):
+			b, _ := ioutil.ReadAll(r.Body)
+			if !strings.Contains(string(b), `<owner id="u9">`) {
+				t.Errorf("update body = %s", b)
+			}
+			id := strings.TrimPrefix(r.URL.Path, base+"/")
+			updated = append(updated, id)
+			writeXml(w, `<dataAlert id="`+id+`"><owner id="u9"/></dataAlert>`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	moved, err := api.TransferDataAlerts("u1", "u9")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(moved) != 2 || moved[1].Owner.ID != "u9" || strings.Join(updated, ",") != "a1,a3" {
+		t.Errorf("moved = %+v, updated %v", moved, updated)
+	}
+}
@@ -0,0 +1,166 @@
+package gotabgo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+// QueryDatabases returns one page of the database assets on the current site.
+func (t *TabApi) QueryDatabases(opts *QueryOptions) (d []model.Database, page model.Pagination, err error) {
+	url := fmt.Sprintf("%s/databases%s", t.getSiteUrl(), opts.query())
+	tResponse, err := t.send("QueryDatabases", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, page, err
+	}
+	if tResponse.Databases != nil {
+		d = tResponse.Databases.Database
+	}
+
+	return d, tResponse.Pagination, nil
+}
+
+// QueryDatabase returns the database asset with the given ID.
+func (t *TabApi) QueryDatabase(id string) (d *model.Database, err error) {
+	url := fmt.Sprintf("%s/databases/%s", t.getSiteUrl(), id)
+	tResponse, err := t.send("QueryDatabase", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Database, nil
+}
+
+// UpdateDatabase changes the description, contact, certification or content
+// permissions of the database identified by database.ID.
+func (t *TabApi) UpdateDatabase(database model.Database) (d *model.Database, err error) {
+	if database.ID == "" {
+		return nil, errors.New("Database ID is required")
+	}
+	url := fmt.Sprintf("%s/databases/%s", t.getSiteUrl(), database.ID)
+	update := model.Database{
+		Description:        database.Description,
+		IsCertified:        database.IsCertified,
+		CertificationNote:  database.CertificationNote,
+		ContentPermissions: database.ContentPermissions,
+		Contact:            database.Contact,
+	}
+	tsRequest := model.TsRequest{Database: &update}
+	tResponse, err := t.send("UpdateDatabase", http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Database, nil
+}
+
+// RemoveDatabase removes a database asset from the catalog.
+func (t *TabApi) RemoveDatabase(id string) (err error) {
+	url := fmt.Sprintf("%s/databases/%s", t.getSiteUrl(), id)
+	_, err = t.send("RemoveDatabase", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
+
+// QueryTables returns one page of the table assets on the current site.
+func (t *TabApi) QueryTables(opts *QueryOptions) (tables []model.Table, page model.Pagination, err error) {
+	url := fmt.Sprintf("%s/tables%s", t.getSiteUrl(), opts.query())
+	tResponse, err := t.send("QueryTables", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, page, err
+	}
+	if tResponse.Tables != nil {
+		tables = tResponse.Tables.Table
+	}
+
+	return tables, tResponse.Pagination, nil
+}
+
+// QueryTable returns the table asset with the given ID.
+func (t *TabApi) QueryTable(id string) (table *model.Table, err error) {
+	url := fmt.Sprintf("%s/tables/%s", t.getSiteUrl(), id)
+	tResponse, err := t.send("QueryTable", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Table, nil
+}
+
+// UpdateTable changes the description, contact or certification of the table
+// identified by table.ID.
+func (t *TabApi) UpdateTable(table model.Table) (tb *model.Table, err error) {
+	if table.ID == "" {
+		return nil, errors.New("Table ID is required")
+	}
+	url := fmt.Sprintf("%s/tables/%s", t.getSiteUrl(), table.ID)
+	update := model.Table{
+		Description:       table.Description,
+		IsCertified:       table.IsCertified,
+		CertificationNote: table.CertificationNote,
+		Contact:           table.Contact,
+	}
+	tsRequest := model.TsRequest{Table: &update}
+	tResponse, err := t.send("UpdateTable", http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Table, nil
+}
+
+// RemoveTable removes a table asset from the catalog.
+func (t *TabApi) RemoveTable(id string) (err error) {
+	url := fmt.Sprintf("%s/tables/%s", t.getSiteUrl(), id)
+	_, err = t.send("RemoveTable", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
+
+// QueryColumns returns one page of the columns of a table.
+func (t *TabApi) QueryColumns(tableID string, opts *QueryOptions) (c []model.Column, page model.Pagination, err error) {
+	url := fmt.Sprintf("%s/tables/%s/columns%s", t.getSiteUrl(), tableID, opts.query())
+	tResponse, err := t.send("QueryColumns", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, page, err
+	}
+	if tResponse.Columns != nil {
+		c = tResponse.Columns.Column
+	}
+
+	return c, tResponse.Pagination, nil
+}
+
+// QueryColumn returns a single column of a table.
+func (t *TabApi) QueryColumn(tableID, columnID string) (c *model.Column, err error) {
+	url := fmt.Sprintf("%s/tables/%s/columns/%s", t.getSiteUrl(), tableID, columnID)
+	tResponse, err := t.send("QueryColumn", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Column, nil
+}
+
+// UpdateColumn changes the description of the column identified by
+// column.ID.
+func (t *TabApi) UpdateColumn(tableID string, column model.Column) (c *model.Column, err error) {
+	if column.ID == "" {
+		return nil, errors.New("Column ID is required")
+	}
+	url := fmt.Sprintf("%s/tables/%s/columns/%s", t.getSiteUrl(), tableID, column.ID)
+	tsRequest := model.TsRequest{Column: &model.Column{Description: column.Description}}
+	tResponse, err := t.send("UpdateColumn", http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Column, nil
+}
+
+// RemoveColumn removes a column asset from the catalog.
+func (t *TabApi) RemoveColumn(tableID, columnID string) (err error) {
+	url := fmt.Sprintf("%s/tables/%s/columns/%s", t.getSiteUrl(), tableID, columnID)
+	_, err = t.send("RemoveColumn", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
@@ -0,0 +1,179 @@
+package gotabgo
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+func TestDatabaseEndpoints(t *testing.T) {
+	const database = `<database id="db1" name="sales" type="DatabaseServer" connectionType="postgres"
+		hostName="pg.example.com" port="5432" isCertified="true" contentPermissions="ManagedByOwner"><contact id="u1"/></database>`
+	certified := false
+	runEndpointTests(t, []endpointTest{
+		{
+			name: "QueryDatabases", status: http.StatusOK,
+			resp: `<pagination pageNumber="1" pageSize="100" totalAvailable="1"/><databases>` + database + `</databases>`,
+			call: func(api *TabApi) (string, error) {
+				d, page, err := api.QueryDatabases(nil)
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprint(d[0].HostName, ":", d[0].Port, " ", page.HasMore()), nil
+			},
+			method: http.MethodGet, url: "databases", want: "pg.example.com:5432 false",
+		},
+		{
+			name: "QueryDatabase", status: http.StatusOK, resp: database,
+			call: func(api *TabApi) (string, error) {
+				d, err := api.QueryDatabase("db1")
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprint(d.ContentPermissions, " ", *d.IsCertified, " ", d.Contact.ID), nil
+			},
+			method: http.MethodGet, url: "databases/db1", want: "ManagedByOwner true u1",
+		},
+		{
+			name: "UpdateDatabase", status: http.StatusOK, resp: database,
+			call: func(api *TabApi) (string, error) {
+				d, err := api.UpdateDatabase(model.Database{
+					ID: "db1", Name: "ignored", IsCertified: &certified,
+					ContentPermissions: model.LockedToProject, Contact: &model.Contact{ID: "u2"},
+				})
+				if err != nil {
+					return "", err
+				}
+				return d.ID, nil
+			},
+			method: http.MethodPut, url: "databases/db1",
+			payload: `<database isCertified="false" contentPermissions="LockedToProject"><contact id="u2"></contact></database>`,
+			want:    "db1",
+		},
+		{
+			name: "RemoveDatabase", status: http.StatusNoContent,
+			call: func(api *TabApi) (string, error) {
+				return "", api.RemoveDatabase("db1")
+			},
+			method: http.MethodDelete, url: "databases/db1",
+		},
+		{
+			name: "QueryPermissions database", status: http.StatusOK,
+			resp: `<permissions><database id="db1"/><granteeCapabilities><user id="u1"/>
+				<capabilities><capability name="Read" mode="Allow"/></capabilities></granteeCapabilities></permissions>`,
+			call: func(api *TabApi) (string, error) {
+				p, err := api.QueryPermissions(model.ResourceDatabases, "db1")
+				if err != nil {
+					return "", err
+				}
+				return p.Database.ID, nil
+			},
+			method: http.MethodGet, url: "databases/db1/permissions", want: "db1",
+		},
+	})
+}
+
+func TestTableEndpoints(t *testing.T) {
+	const table = `<table id="t1" name="orders" schema="public" isEmbedded="false"><contact id="u1"/></table>`
+	const column = `<column id="c1" name="amount" description="Order total" remoteType="NUMERIC"/>`
+	runEndpointTests(t, []endpointTest{
+		{
+			name: "QueryTables", status: http.StatusOK,
+			resp: `<pagination pageNumber="1" pageSize="1" totalAvailable="2"/><tables>` + table + `</tables>`,
+			call: func(api *TabApi) (string, error) {
+				tables, page, err := api.QueryTables(&QueryOptions{PageSize: 1})
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprint(tables[0].Schema, ".", tables[0].Name, " ", page.HasMore()), nil
+			},
+			method: http.MethodGet, url: "tables?pageSize=1", want: "public.orders true",
+		},
+		{
+			name: "QueryTable", status: http.StatusOK, resp: table,
+			call: func(api *TabApi) (string, error) {
+				tb, err := api.QueryTable("t1")
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprint(*tb.IsEmbedded, " ", tb.Contact.ID), nil
+			},
+			method: http.MethodGet, url: "tables/t1", want: "false u1",
+		},
+		{
+			name: "UpdateTable", status: http.StatusOK, resp: table,
+			call: func(api *TabApi) (string, error) {
+				tb, err := api.UpdateTable(model.Table{ID: "t1", Schema: "ignored", Description: "Orders"})
+				if err != nil {
+					return "", err
+				}
+				return tb.ID, nil
+			},
+			method: http.MethodPut, url: "tables/t1",
+			payload: `<table description="Orders"></table>`, want: "t1",
+		},
+		{
+			name: "RemoveTable", status: http.StatusNoContent,
+			call: func(api *TabApi) (string, error) {
+				return "", api.RemoveTable("t1")
+			},
+			method: http.MethodDelete, url: "tables/t1",
+		},
+		{
+			name: "QueryColumns", status: http.StatusOK,
+			resp: `<pagination pageNumber="1" pageSize="100" totalAvailable="1"/><columns>` + column + `</columns>`,
+			call: func(api *TabApi) (string, error) {
+				c, _, err := api.QueryColumns("t1", nil)
+				if err != nil {
+					return "", err
+				}
+				return c[0].RemoteType, nil
+			},
+			method: http.MethodGet, url: "tables/t1/columns", want: "NUMERIC",
+		},
+		{
+			name: "QueryColumn", status: http.StatusOK, resp: column,
+			call: func(api *TabApi) (string, error) {
+				c, err := api.QueryColumn("t1", "c1")
+				if err != nil {
+					return "", err
+				}
+				return c.Description, nil
+			},
+			method: http.MethodGet, url: "tables/t1/columns/c1", want: "Order total",
+		},
+		{
+			name: "UpdateColumn", status: http.StatusOK, resp: column,
+			call: func(api *TabApi) (string, error) {
+				c, err := api.UpdateColumn("t1", model.Column{ID: "c1", Name: "ignored", Description: "Total"})
+				if err != nil {
+					return "", err
+				}
+				return c.ID, nil
+			},
+			method: http.MethodPut, url: "tables/t1/columns/c1",
+			payload: `<column description="Total"></column>`, want: "c1",
+		},
+		{
+			name: "RemoveColumn", status: http.StatusNoContent,
+			call: func(api *TabApi) (string, error) {
+				return "", api.RemoveColumn("t1", "c1")
+			},
+			method: http.MethodDelete, url: "tables/t1/columns/c1",
+		},
+		{
+			name: "QueryPermissions table", status: http.StatusOK,
+			resp: `<permissions><table id="t1"/></permissions>`,
+			call: func(api *TabApi) (string, error) {
+				p, err := api.QueryPermissions(model.ResourceTables, "t1")
+				if err != nil {
+					return "", err
+				}
+				return p.Table.ID, nil
+			},
+			method: http.MethodGet, url: "tables/t1/permissions", want: "t1",
+		},
+	})
+}
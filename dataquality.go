@@ -0,0 +1,162 @@
+package gotabgo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+// AddDataQualityWarning attaches a data quality warning to a data source,
+// database, table or flow.
+func (t *TabApi) AddDataQualityWarning(contentType model.AssetType, contentID string, warning model.DataQualityWarning) (w *model.DataQualityWarning, err error) {
+	if warning.Type == "" {
+		return nil, errors.New("Data quality warning type is required")
+	}
+	url := fmt.Sprintf("%s/dataQualityWarnings/%s/%s", t.getSiteUrl(), contentType, contentID)
+	add := model.DataQualityWarning{
+		Type:     warning.Type,
+		Message:  warning.Message,
+		IsActive: warning.IsActive,
+		IsSevere: warning.IsSevere,
+	}
+	tsRequest := model.TsRequest{DataQualityWarning: &add}
+	tResponse, err := t.send("AddDataQualityWarning", http.MethodPost, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.DataQualityWarning, nil
+}
+
+// QueryDataQualityWarnings lists the data quality warnings on an asset.
+func (t *TabApi) QueryDataQualityWarnings(contentType model.AssetType, contentID string) (w []model.DataQualityWarning, err error) {
+	url := fmt.Sprintf("%s/dataQualityWarnings/%s/%s", t.getSiteUrl(), contentType, contentID)
+	tResponse, err := t.send("QueryDataQualityWarnings", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	if tResponse.DataQualityWarningList != nil {
+		w = tResponse.DataQualityWarningList.DataQualityWarning
+	}
+
+	return w, nil
+}
+
+// QueryDataQualityWarning returns the data quality warning with the given ID.
+func (t *TabApi) QueryDataQualityWarning(id string) (w *model.DataQualityWarning, err error) {
+	url := fmt.Sprintf("%s/dataQualityWarnings/%s", t.getSiteUrl(), id)
+	tResponse, err := t.send("QueryDataQualityWarning", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.DataQualityWarning, nil
+}
+
+// UpdateDataQualityWarning changes the type, message, active or severe flag
+// of the warning identified by warning.ID.
+func (t *TabApi) UpdateDataQualityWarning(warning model.DataQualityWarning) (w *model.DataQualityWarning, err error) {
+	if warning.ID == "" {
+		return nil, errors.New("Data quality warning ID is required")
+	}
+	url := fmt.Sprintf("%s/dataQualityWarnings/%s", t.getSiteUrl(), warning.ID)
+	update := model.DataQualityWarning{
+		Type:     warning.Type,
+		Message:  warning.Message,
+		IsActive: warning.IsActive,
+		IsSevere: warning.IsSevere,
+	}
+	tsRequest := model.TsRequest{DataQualityWarning: &update}
+	tResponse, err := t.send("UpdateDataQualityWarning", http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.DataQualityWarning, nil
+}
+
+// DeleteDataQualityWarning removes a single data quality warning.
+func (t *TabApi) DeleteDataQualityWarning(id string) (err error) {
+	url := fmt.Sprintf("%s/dataQualityWarnings/%s", t.getSiteUrl(), id)
+	_, err = t.send("DeleteDataQualityWarning", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
+
+// DeleteDataQualityWarnings removes every data quality warning on an asset.
+func (t *TabApi) DeleteDataQualityWarnings(contentType model.AssetType, contentID string) (err error) {
+	url := fmt.Sprintf("%s/dataQualityWarnings/%s/%s", t.getSiteUrl(), contentType, contentID)
+	_, err = t.send("DeleteDataQualityWarnings", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
+
+// GetLabel returns the label with the given ID.
+func (t *TabApi) GetLabel(id string) (l *model.Label, err error) {
+	url := fmt.Sprintf("%s/labels/%s", t.getSiteUrl(), id)
+	tResponse, err := t.send("GetLabel", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Label, nil
+}
+
+// GetLabelsOnAssets returns the labels attached to the given assets.
+func (t *TabApi) GetLabelsOnAssets(assets []model.AssetContent) (l []model.Label, err error) {
+	url := fmt.Sprintf("%s/labels", t.getSiteUrl())
+	tsRequest := model.TsRequest{ContentList: &model.ContentList{Content: assets}}
+	return t.labels("GetLabelsOnAssets", http.MethodPost, url, &tsRequest)
+}
+
+// UpdateLabelsOnAssets sets label on each of the given assets, creating it
+// where it does not exist yet.
+func (t *TabApi) UpdateLabelsOnAssets(assets []model.AssetContent, label model.Label) (l []model.Label, err error) {
+	if label.Value == "" {
+		return nil, errors.New("Label value is required")
+	}
+	url := fmt.Sprintf("%s/labels", t.getSiteUrl())
+	update := model.Label{
+		Value:    label.Value,
+		Category: label.Category,
+		Message:  label.Message,
+		Active:   label.Active,
+		Elevated: label.Elevated,
+	}
+	tsRequest := model.TsRequest{
+		ContentList: &model.ContentList{Content: assets},
+		Label:       &update,
+	}
+	return t.labels("UpdateLabelsOnAssets", http.MethodPut, url, &tsRequest)
+}
+
+// DeleteLabel removes a label.
+func (t *TabApi) DeleteLabel(id string) (err error) {
+	url := fmt.Sprintf("%s/labels/%s", t.getSiteUrl(), id)
+	_, err = t.send("DeleteLabel", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
+
+// DeleteLabelsOnAssets removes the labels of a category from the given
+// assets.
+func (t *TabApi) DeleteLabelsOnAssets(assets []model.AssetContent, category string) (err error) {
+	url := fmt.Sprintf("%s/labels", t.getSiteUrl())
+	tsRequest := model.TsRequest{
+		ContentList: &model.ContentList{Content: assets},
+		Label:       &model.Label{Category: category},
+	}
+	_, err = t.send("DeleteLabelsOnAssets", http.MethodDelete, url, &tsRequest, http.StatusOK)
+	return
+}
+
+func (t *TabApi) labels(caller, method, url string, tsRequest *model.TsRequest) (l []model.Label, err error) {
+	tResponse, err := t.send(caller, method, url, tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	if tResponse.LabelList != nil {
+		l = tResponse.LabelList.Label
+	}
+
+	return l, nil
+}
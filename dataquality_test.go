@@ -0,0 +1,138 @@
+package gotabgo
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+func TestDataQualityWarningEndpoints(t *testing.T) {
+	const warning = `<dataQualityWarning id="w1" type="Stale data" message="Refresh failed" isActive="true" isSevere="false"
+		contentId="d1" contentType="datasource"><owner id="u1"/></dataQualityWarning>`
+	active, severe := true, true
+	runEndpointTests(t, []endpointTest{
+		{
+			name: "AddDataQualityWarning", status: http.StatusOK, resp: warning,
+			call: func(api *TabApi) (string, error) {
+				w, err := api.AddDataQualityWarning(model.AssetDatasource, "d1", model.DataQualityWarning{
+					ID: "ignored", Type: model.WarningStaleData, Message: "Refresh failed", IsActive: &active,
+				})
+				if err != nil {
+					return "", err
+				}
+				return w.ID, nil
+			},
+			method: http.MethodPost, url: "dataQualityWarnings/datasource/d1",
+			payload: `<dataQualityWarning type="Stale data" message="Refresh failed" isActive="true"></dataQualityWarning>`,
+			want:    "w1",
+		},
+		{
+			name: "QueryDataQualityWarnings", status: http.StatusOK,
+			resp: `<dataQualityWarningList>` + warning + `<dataQualityWarning id="w2"/></dataQualityWarningList>`,
+			call: func(api *TabApi) (string, error) {
+				w, err := api.QueryDataQualityWarnings(model.AssetTable, "t1")
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprint(len(w), " ", w[0].ContentType, " ", *w[0].IsSevere), nil
+			},
+			method: http.MethodGet, url: "dataQualityWarnings/table/t1", want: "2 datasource false",
+		},
+		{
+			name: "QueryDataQualityWarning", status: http.StatusOK, resp: warning,
+			call: func(api *TabApi) (string, error) {
+				w, err := api.QueryDataQualityWarning("w1")
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprint(w.Type, " ", w.Owner.ID), nil
+			},
+			method: http.MethodGet, url: "dataQualityWarnings/w1", want: "Stale data u1",
+		},
+		{
+			name: "UpdateDataQualityWarning", status: http.StatusOK, resp: warning,
+			call: func(api *TabApi) (string, error) {
+				w, err := api.UpdateDataQualityWarning(model.DataQualityWarning{ID: "w1", IsSevere: &severe})
+				if err != nil {
+					return "", err
+				}
+				return w.ID, nil
+			},
+			method: http.MethodPut, url: "dataQualityWarnings/w1",
+			payload: `<dataQualityWarning isSevere="true"></dataQualityWarning>`, want: "w1",
+		},
+		{
+			name: "DeleteDataQualityWarning", status: http.StatusNoContent,
+			call: func(api *TabApi) (string, error) {
+				return "", api.DeleteDataQualityWarning("w1")
+			},
+			method: http.MethodDelete, url: "dataQualityWarnings/w1",
+		},
+		{
+			name: "DeleteDataQualityWarnings", status: http.StatusNoContent,
+			call: func(api *TabApi) (string, error) {
+				return "", api.DeleteDataQualityWarnings(model.AssetFlow, "f1")
+			},
+			method: http.MethodDelete, url: "dataQualityWarnings/flow/f1",
+		},
+	})
+}
+
+func TestLabelEndpoints(t *testing.T) {
+	const label = `<label id="l1" value="Warning" category="warning" message="Check" active="true" contentId="d1" contentType="datasource"/>`
+	assets := []model.AssetContent{{ContentType: model.AssetDatasource, ContentID: "d1"}}
+	const assetsXml = `<contentList><content contentType="datasource" contentId="d1"></content></contentList>`
+	runEndpointTests(t, []endpointTest{
+		{
+			name: "GetLabel", status: http.StatusOK, resp: label,
+			call: func(api *TabApi) (string, error) {
+				l, err := api.GetLabel("l1")
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprint(l.Value, " ", l.Category, " ", *l.Active), nil
+			},
+			method: http.MethodGet, url: "labels/l1", want: "Warning warning true",
+		},
+		{
+			name: "GetLabelsOnAssets", status: http.StatusOK, resp: `<labelList>` + label + `</labelList>`,
+			call: func(api *TabApi) (string, error) {
+				l, err := api.GetLabelsOnAssets(assets)
+				if err != nil {
+					return "", err
+				}
+				return l[0].ContentID, nil
+			},
+			method: http.MethodPost, url: "labels", payload: assetsXml, want: "d1",
+		},
+		{
+			name: "UpdateLabelsOnAssets", status: http.StatusOK, resp: `<labelList>` + label + `</labelList>`,
+			call: func(api *TabApi) (string, error) {
+				l, err := api.UpdateLabelsOnAssets(assets, model.Label{ID: "ignored", Value: "Warning", Message: "Check"})
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprint(len(l)), nil
+			},
+			method: http.MethodPut, url: "labels",
+			payload: `<label value="Warning" message="Check"></label>` + assetsXml, want: "1",
+		},
+		{
+			name: "DeleteLabel", status: http.StatusNoContent,
+			call: func(api *TabApi) (string, error) {
+				return "", api.DeleteLabel("l1")
+			},
+			method: http.MethodDelete, url: "labels/l1",
+		},
+		{
+			name: "DeleteLabelsOnAssets", status: http.StatusOK,
+			call: func(api *TabApi) (string, error) {
+				return "", api.DeleteLabelsOnAssets(assets, "warning")
+			},
+			method: http.MethodDelete, url: "labels",
+			payload: `<label category="warning"></label>` + assetsXml,
+		},
+	})
+}
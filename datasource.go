@@ -0,0 +1,96 @@
+package gotabgo
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/groundfoundation/gotabgo/model"
+	log "github.com/sirupsen/logrus"
+)
+
+// QueryDatasource returns the data source with the given ID.
+func (t *TabApi) QueryDatasource(id string) (d *model.Datasource, err error) {
+	url := fmt.Sprintf("%s/datasources/%s", t.getSiteUrl(), id)
+	tResponse, err := t.send("QueryDatasource", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	if tResponse.Datasource == nil {
+		return nil, errors.New("Datasource Not Found on site")
+	}
+
+	return tResponse.Datasource, nil
+}
+
+// PublishDatasource uploads a .tds, .tdsx, .tde or .hyper file as
+// datasource.Name into datasource.Project. Files larger than 64MB must be
+// published in chunks, which this does not do.
+func (t *TabApi) PublishDatasource(datasource model.Datasource, filename string, file io.Reader, overwrite bool) (d *model.Datasource, err error) {
+	if datasource.Name == "" || datasource.Project == nil {
+		return nil, errors.New("Datasource name and project are required")
+	}
+	ext := strings.TrimPrefix(path.Ext(filename), ".")
+	switch ext {
+	case "tds", "tdsx", "tde", "hyper":
+	default:
+		return nil, fmt.Errorf("Datasource file must be a .tds, .tdsx, .tde or .hyper: %s", filename)
+	}
+	url := fmt.Sprintf("%s/datasources?datasourceType=%s&overwrite=%t", t.getSiteUrl(), ext, overwrite)
+	publish := model.Datasource{
+		Name:        datasource.Name,
+		Description: datasource.Description,
+		Project:     &model.Project{ID: datasource.Project.ID},
+	}
+	tsRequest := model.TsRequest{Datasource: &publish}
+	tResponse, err := t.publish("PublishDatasource", url, &tsRequest, "tableau_datasource", filename, file)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Datasource, nil
+}
+
+// ListDatasourceRevisions returns one page of the revision history of a data
+// source. Revision history must be enabled on the site.
+func (t *TabApi) ListDatasourceRevisions(datasourceID string, opts *QueryOptions) (r []model.Revision, page model.Pagination, err error) {
+	url := fmt.Sprintf("%s/datasources/%s/revisions%s", t.getSiteUrl(), datasourceID, opts.query())
+	return t.listRevisions("ListDatasourceRevisions", url)
+}
+
+// DownloadDatasourceRevision writes the file of a data source revision to w
+// and returns its file name.
+func (t *TabApi) DownloadDatasourceRevision(datasourceID, revision string, w io.Writer) (filename string, err error) {
+	url := fmt.Sprintf("%s/datasources/%s/revisions/%s/content", t.getSiteUrl(), datasourceID, revision)
+	return t.download("DownloadDatasourceRevision", url, w)
+}
+
+// RemoveDatasourceRevision deletes a revision from the history of a data
+// source.
+func (t *TabApi) RemoveDatasourceRevision(datasourceID, revision string) (err error) {
+	url := fmt.Sprintf("%s/datasources/%s/revisions/%s", t.getSiteUrl(), datasourceID, revision)
+	_, err = t.send("RemoveDatasourceRevision", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
+
+// RestoreDatasourceRevision makes a prior revision the current one by
+// downloading it and publishing it over the data source.
+func (t *TabApi) RestoreDatasourceRevision(datasourceID, revision string) (d *model.Datasource, err error) {
+	current, err := t.QueryDatasource(datasourceID)
+	if err != nil {
+		return nil, err
+	}
+	var file bytes.Buffer
+	filename, err := t.DownloadDatasourceRevision(datasourceID, revision, &file)
+	if err != nil {
+		return nil, err
+	}
+	log.WithField("method", "RestoreDatasourceRevision").
+		Debugf("restoring %s revision %s from %s", current.Name, revision, filename)
+
+	return t.PublishDatasource(*current, filename, &file, true)
+}
@@ -0,0 +1,17 @@
+package gotabgo
+
+import "testing"
+
+func TestRestoreDatasourceRevision(t *testing.T) {
+	var name, body string
+	api := restoreStub(t, "datasources", "tableau_datasource",
+		`<datasource id="c1" name="Sales"><project id="p1"/></datasource>`,
+		"Sales.tdsx", func(n, b string) { name, body = n, b })
+	ds, err := api.RestoreDatasourceRevision("c1", "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ds.ID != "c1" || name != "Sales.tdsx" || body != "revision 2" {
+		t.Errorf("datasource = %+v, published %q with %q", ds, name, body)
+	}
+}
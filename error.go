@@ -2,6 +2,10 @@ package gotabgo
 
 import (
 	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/groundfoundation/gotabgo/model"
 )
 
 type ApiError struct {
@@ -13,3 +17,76 @@ func (e *ApiError) Error() string {
 	return fmt.Sprintf("%d - %s", e.code, e.message)
 
 }
+
+// newApiError builds an ApiError from an unexpected response, preferring the
+// summary and detail Tableau returns over the bare HTTP status.
+func newApiError(r *http.Response, tResponse *model.TsResponse) *ApiError {
+	if tResponse == nil || tResponse.Error.Summary == "" {
+		return &ApiError{r.StatusCode, r.Status}
+	}
+	msg := tResponse.Error.Summary
+	if tResponse.Error.Detail != "" {
+		msg += ": " + tResponse.Error.Detail
+	}
+	if tResponse.Error.Code != 0 {
+		msg = fmt.Sprintf("%d %s", tResponse.Error.Code, msg)
+	}
+
+	return &ApiError{r.StatusCode, msg}
+}
+
+// JobError reports an asynchronous job that failed or was cancelled.
+type JobError struct {
+	ID         string
+	FinishCode int
+}
+
+func (e *JobError) Error() string {
+	if e.FinishCode == model.JobCancelled {
+		return fmt.Sprintf("job %s was cancelled", e.ID)
+	}
+	return fmt.Sprintf("job %s failed with finish code %d", e.ID, e.FinishCode)
+}
+
+// MetadataError carries the errors a Metadata API query returned.
+type MetadataError struct {
+	Errors []model.GraphQLError
+}
+
+func (e *MetadataError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, ge := range e.Errors {
+		msgs[i] = ge.Message
+	}
+	return "metadata query failed: " + strings.Join(msgs, "; ")
+}
+
+// VersionError reports an endpoint that needs a newer REST API version than
+// the server supports (Server is set) or than the TabApi was created with
+// (Client is set).
+type VersionError struct {
+	Feature  string
+	Required string
+	Server   string
+	Client   string
+}
+
+func (e *VersionError) Error() string {
+	if e.Client != "" {
+		return fmt.Sprintf("%s requires REST API %s, requests use %s; set ApiVersion to %s or later",
+			e.Feature, e.Required, e.Client, e.Required)
+	}
+	return fmt.Sprintf("%s requires REST API %s, server supports %s", e.Feature, e.Required, e.Server)
+}
+
+// TrustedTicketError reports a trusted ticket request the server refused by
+// returning a ticket of "-1". The server does not trust the requesting host,
+// the client IP does not match, or the user is not a member of the site.
+type TrustedTicketError struct {
+	Username string
+	Site     string
+}
+
+func (e *TrustedTicketError) Error() string {
+	return fmt.Sprintf("trusted ticket refused for user %s on site %q", e.Username, e.Site)
+}
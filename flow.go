@@ -0,0 +1,166 @@
+package gotabgo
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+// QueryFlows returns one page of the flows on the current site.
+func (t *TabApi) QueryFlows(opts *QueryOptions) (f []model.Flow, page model.Pagination, err error) {
+	url := fmt.Sprintf("%s/flows%s", t.getSiteUrl(), opts.query())
+	tResponse, err := t.send("QueryFlows", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, page, err
+	}
+	if tResponse.Flows != nil {
+		f = tResponse.Flows.Flow
+	}
+
+	return f, tResponse.Pagination, nil
+}
+
+// QueryFlow returns the flow with the given ID.
+func (t *TabApi) QueryFlow(id string) (f *model.Flow, err error) {
+	url := fmt.Sprintf("%s/flows/%s", t.getSiteUrl(), id)
+	tResponse, err := t.send("QueryFlow", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	if tResponse.Flow == nil {
+		return nil, errors.New("Flow Not Found on site")
+	}
+
+	return tResponse.Flow, nil
+}
+
+// PublishFlow uploads a .tfl or .tflx file as flow.Name into flow.Project.
+func (t *TabApi) PublishFlow(flow model.Flow, filename string, file io.Reader, overwrite bool) (f *model.Flow, err error) {
+	if flow.Name == "" || flow.Project == nil {
+		return nil, errors.New("Flow name and project are required")
+	}
+	ext := strings.TrimPrefix(path.Ext(filename), ".")
+	if ext != "tfl" && ext != "tflx" {
+		return nil, fmt.Errorf("Flow file must be a .tfl or .tflx: %s", filename)
+	}
+	url := fmt.Sprintf("%s/flows?flowType=%s&overwrite=%t", t.getSiteUrl(), ext, overwrite)
+	publish := model.Flow{
+		Name:        flow.Name,
+		Description: flow.Description,
+		Project:     &model.Project{ID: flow.Project.ID},
+	}
+	tsRequest := model.TsRequest{Flow: &publish}
+	tResponse, err := t.publish("PublishFlow", url, &tsRequest, "tableau_flow", filename, file)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Flow, nil
+}
+
+// DownloadFlow writes the flow file to w and returns its file name.
+func (t *TabApi) DownloadFlow(id string, w io.Writer) (filename string, err error) {
+	url := fmt.Sprintf("%s/flows/%s/content", t.getSiteUrl(), id)
+	return t.download("DownloadFlow", url, w)
+}
+
+// UpdateFlow moves the flow identified by flow.ID to another project or
+// changes its owner.
+func (t *TabApi) UpdateFlow(flow model.Flow) (f *model.Flow, err error) {
+	if flow.ID == "" {
+		return nil, errors.New("Flow ID is required")
+	}
+	url := fmt.Sprintf("%s/flows/%s", t.getSiteUrl(), flow.ID)
+	var update model.Flow
+	if flow.Project != nil {
+		update.Project = &model.Project{ID: flow.Project.ID}
+	}
+	if flow.Owner != nil {
+		update.Owner = &model.Owner{ID: flow.Owner.ID}
+	}
+	tsRequest := model.TsRequest{Flow: &update}
+	tResponse, err := t.send("UpdateFlow", http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Flow, nil
+}
+
+// DeleteFlow removes a flow from the site.
+func (t *TabApi) DeleteFlow(id string) (err error) {
+	url := fmt.Sprintf("%s/flows/%s", t.getSiteUrl(), id)
+	_, err = t.send("DeleteFlow", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
+
+// QueryFlowConnections lists the input and output connections of a flow.
+func (t *TabApi) QueryFlowConnections(flowID string) (c []model.Connection, err error) {
+	url := fmt.Sprintf("%s/flows/%s/connections", t.getSiteUrl(), flowID)
+	tResponse, err := t.send("QueryFlowConnections", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	if tResponse.Connections != nil {
+		c = tResponse.Connections.Connection
+	}
+
+	return c, nil
+}
+
+// RunFlow starts a flow immediately and returns the job that tracks the run.
+// Pass the job ID to WaitForFlowRun to block until it is done.
+func (t *TabApi) RunFlow(flowID string) (job *model.Job, err error) {
+	url := fmt.Sprintf("%s/flows/%s/run", t.getSiteUrl(), flowID)
+	tResponse, err := t.send("RunFlow", http.MethodPost, url, &model.TsRequest{}, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Job, nil
+}
+
+// QueryFlowRuns returns one page of flow runs on the current site. Filter on
+// "flowId:eq:<id>" to see the runs of a single flow.
+func (t *TabApi) QueryFlowRuns(opts *QueryOptions) (r []model.FlowRun, page model.Pagination, err error) {
+	url := fmt.Sprintf("%s/flows/runs%s", t.getSiteUrl(), opts.query())
+	tResponse, err := t.send("QueryFlowRuns", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, page, err
+	}
+	if tResponse.FlowRuns != nil {
+		r = tResponse.FlowRuns.FlowRun
+	}
+
+	return r, tResponse.Pagination, nil
+}
+
+// QueryFlowRun returns a single flow run.
+func (t *TabApi) QueryFlowRun(id string) (r *model.FlowRun, err error) {
+	url := fmt.Sprintf("%s/flows/runs/%s", t.getSiteUrl(), id)
+	tResponse, err := t.send("QueryFlowRun", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.FlowRun, nil
+}
+
+// CancelFlowRun stops a flow run that is pending or in progress.
+func (t *TabApi) CancelFlowRun(id string) (err error) {
+	url := fmt.Sprintf("%s/flows/runs/%s/cancel", t.getSiteUrl(), id)
+	_, err = t.send("CancelFlowRun", http.MethodPut, url, nil, http.StatusOK)
+	return
+}
+
+// WaitForFlowRun blocks until the job started by RunFlow completes. See
+// WaitForJob.
+func (t *TabApi) WaitForFlowRun(jobID string, interval, timeout time.Duration) (job *model.Job, err error) {
+	return t.WaitForJob(jobID, interval, timeout)
+}
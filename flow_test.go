@@ -0,0 +1,28 @@
+package gotabgo
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDownloadFlow(t *testing.T) {
+	api := newTestApi(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/3.18/sites/site-1/flows/f1/content" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Disposition", `name="tableau_flow"; filename="Prep Sales.tflx"`)
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Write([]byte("flow file"))
+	})
+	var file strings.Builder
+	filename, err := api.DownloadFlow("f1", &file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filename != "Prep Sales.tflx" || file.String() != "flow file" {
+		t.Errorf("filename = %q, file = %q", filename, file.String())
+	}
+}
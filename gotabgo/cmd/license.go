@@ -0,0 +1,77 @@
+/*
+Copyright © 2021 The Authors of gotabgo
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+var licenseShowUsers bool
+
+// licenseCmd represents the license command
+var licenseCmd = &cobra.Command{
+	Use:   "license",
+	Short: "report license usage across all sites",
+	Long: `Count the Creator, Explorer and Viewer licenses in use on every site of
+	the server. Users on more than one site are counted once in the total,
+	at the highest license they hold. Requires a server administrator.`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if e := rootCmd.PersistentPreRunE(cmd, args); e != nil {
+			return e
+		}
+		return signin()
+	},
+	PersistentPostRunE: func(cmd *cobra.Command, args []string) error {
+		return signout()
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		r, e := tabApi.LicenseReport()
+		if e != nil {
+			return e
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "SITE\tCREATORS\tEXPLORERS\tVIEWERS\tUNLICENSED")
+		for _, s := range r.Sites {
+			name := s.Site.ContentUrl
+			if name == "" {
+				name = "(default)"
+			}
+			fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\n", name, s.Counts.Creators,
+				s.Counts.Explorers, s.Counts.Viewers, s.Counts.Unlicensed)
+		}
+		fmt.Fprintf(w, "TOTAL\t%d\t%d\t%d\t%d\n", r.Total.Creators,
+			r.Total.Explorers, r.Total.Viewers, r.Total.Unlicensed)
+		if licenseShowUsers {
+			fmt.Fprintln(w)
+			fmt.Fprintln(w, "USER\tLICENSE\tLAST LOGIN\tSITES")
+			for _, u := range r.Users {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", u.Name, u.Level, u.LastLogin,
+					strings.Join(u.Sites, ","))
+			}
+		}
+		return w.Flush()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(licenseCmd)
+	licenseCmd.Flags().BoolVar(&licenseShowUsers, "users", false, "also list every user with their license and last login")
+}
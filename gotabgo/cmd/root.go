@@ -32,6 +32,7 @@ type opts struct {
 	tls              bool
 	username         string
 	serverApiVersion string
+	site             string
 }
 
 var (
@@ -86,12 +87,14 @@ func init() {
 	rootCmd.PersistentFlags().StringVarP(&options.username, "username", "u", "", "username to use when connecting to Tableau Server")
 	rootCmd.PersistentFlags().StringVarP(&options.password, "password", "p", "", "password for the user")
 	rootCmd.PersistentFlags().StringVarP(&options.server, "server", "s", "", "the hostname of the server")
+	rootCmd.PersistentFlags().StringVar(&options.site, "site", "", "the content url of the site to sign in to (default site when empty)")
 	rootCmd.Flags().StringVarP(&options.serverApiVersion, "apiversion", "a", "3.9", "specify which version of the api to user")
 	rootCmd.Flags().BoolVar(&options.tls, "tls", true, "whether to use TLS or not when connecting")
 
 	viper.BindPFlag("username", rootCmd.PersistentFlags().Lookup("username"))
 	viper.BindPFlag("password", rootCmd.PersistentFlags().Lookup("password"))
 	viper.BindPFlag("server", rootCmd.PersistentFlags().Lookup("server"))
+	viper.BindPFlag("site", rootCmd.PersistentFlags().Lookup("site"))
 	viper.BindPFlag("apiversion", rootCmd.Flags().Lookup("apiversion"))
 	viper.BindPFlag("tls", rootCmd.Flags().Lookup("tls"))
 
@@ -106,6 +109,18 @@ func init() {
 
 }
 
+// signin authenticates tabApi with the configured username and password for
+// commands that need a session.
+func signin() error {
+	return tabApi.Signin(viper.GetString("username"), viper.GetString("password"),
+		viper.GetString("site"), "")
+}
+
+// signout ends the session opened by signin.
+func signout() error {
+	return tabApi.Signout()
+}
+
 // initConfig reads in config file and ENV variables if set.
 func initConfig() {
 	if cfgFile != "" {
@@ -0,0 +1,195 @@
+/*
+Copyright © 2021 The Authors of gotabgo
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/groundfoundation/gotabgo"
+	"github.com/groundfoundation/gotabgo/model"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+type subscriptionOpts struct {
+	subject     string
+	message     string
+	scheduleID  string
+	userID      string
+	viewID      string
+	workbookID  string
+	attachment  string
+	orientation string
+}
+
+var subOpts subscriptionOpts
+
+// subscriptionCmd represents the subscription command group
+var subscriptionCmd = &cobra.Command{
+	Use:   "subscription",
+	Short: "manage email subscriptions to views and workbooks",
+	Long: `Create, list, update and delete the email subscriptions on a site.
+	Subscriptions send a view or workbook to a user on a subscription
+	schedule, optionally with a PDF or PNG attachment.`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if e := rootCmd.PersistentPreRunE(cmd, args); e != nil {
+			return e
+		}
+		return signin()
+	},
+	PersistentPostRunE: func(cmd *cobra.Command, args []string) error {
+		return signout()
+	},
+}
+
+var subscriptionListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "list the subscriptions on the site",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tSUBJECT\tCONTENT\tUSER\tSCHEDULE")
+		opts := &gotabgo.QueryOptions{PageSize: 100}
+		for {
+			subs, page, e := tabApi.QuerySubscriptions(opts)
+			if e != nil {
+				return e
+			}
+			for _, s := range subs {
+				var content, user, schedule string
+				if s.Content != nil {
+					content = s.Content.Type + ":" + s.Content.ID
+				}
+				if s.User != nil {
+					user = s.User.Name
+				}
+				if s.Schedule != nil {
+					schedule = s.Schedule.Name
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", s.ID, s.Subject, content, user, schedule)
+			}
+			if len(subs) == 0 || !page.HasMore() {
+				break
+			}
+			opts.PageNumber = page.PageNumber + 1
+		}
+		return w.Flush()
+	},
+}
+
+var subscriptionCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "subscribe a user to a view or workbook",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		s, e := subscriptionFromFlags(cmd)
+		if e != nil {
+			return e
+		}
+		created, e := tabApi.CreateSubscription(s)
+		if e != nil {
+			return e
+		}
+		log.Debugf("subscription: %v", created)
+		fmt.Println(created.ID)
+		return nil
+	},
+}
+
+var subscriptionUpdateCmd = &cobra.Command{
+	Use:   "update <id>",
+	Short: "change the subject, message, schedule or attachment of a subscription",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		s, e := subscriptionFromFlags(cmd)
+		if e != nil {
+			return e
+		}
+		s.ID = args[0]
+		// content and user can't be changed on an existing subscription
+		s.Content = nil
+		s.User = nil
+		_, e = tabApi.UpdateSubscription(s)
+		return e
+	},
+}
+
+var subscriptionDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "delete a subscription",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return tabApi.DeleteSubscription(args[0])
+	},
+}
+
+// subscriptionFromFlags builds a subscription from the flags set on cmd.
+func subscriptionFromFlags(cmd *cobra.Command) (s model.Subscription, e error) {
+	s.Subject = subOpts.subject
+	s.Message = subOpts.message
+	s.PageOrientation = subOpts.orientation
+	if subOpts.scheduleID != "" {
+		s.Schedule = &model.Schedule{ID: subOpts.scheduleID}
+	}
+	if subOpts.userID != "" {
+		s.User = &model.User{ID: subOpts.userID}
+	}
+	switch {
+	case subOpts.viewID != "" && subOpts.workbookID != "":
+		return s, errors.New("only one of --view and --workbook can be set")
+	case subOpts.viewID != "":
+		s.Content = &model.SubscriptionContent{ID: subOpts.viewID, Type: model.SubscriptionContentView}
+	case subOpts.workbookID != "":
+		s.Content = &model.SubscriptionContent{ID: subOpts.workbookID, Type: model.SubscriptionContentWorkbook}
+	}
+	if cmd.Flags().Changed("attachment") {
+		attachPdf, attachImage := false, false
+		switch strings.ToLower(subOpts.attachment) {
+		case "pdf":
+			attachPdf = true
+		case "png":
+			attachImage = true
+		case "none":
+		default:
+			return s, fmt.Errorf("unknown attachment type %q, use pdf, png or none", subOpts.attachment)
+		}
+		s.AttachPdf = &attachPdf
+		s.AttachImage = &attachImage
+	}
+	return s, nil
+}
+
+func init() {
+	rootCmd.AddCommand(subscriptionCmd)
+	subscriptionCmd.AddCommand(subscriptionListCmd, subscriptionCreateCmd,
+		subscriptionUpdateCmd, subscriptionDeleteCmd)
+
+	for _, c := range []*cobra.Command{subscriptionCreateCmd, subscriptionUpdateCmd} {
+		c.Flags().StringVar(&subOpts.subject, "subject", "", "subject of the subscription email")
+		c.Flags().StringVar(&subOpts.message, "message", "", "message in the body of the email")
+		c.Flags().StringVar(&subOpts.scheduleID, "schedule", "", "id of the subscription schedule")
+		c.Flags().StringVar(&subOpts.attachment, "attachment", "none", "attach the content as pdf, png or none")
+		c.Flags().StringVar(&subOpts.orientation, "orientation", "", "page orientation of a pdf attachment, Portrait or Landscape")
+	}
+	subscriptionCreateCmd.Flags().StringVar(&subOpts.userID, "user", "", "id of the user to subscribe")
+	subscriptionCreateCmd.Flags().StringVar(&subOpts.viewID, "view", "", "id of the view to send")
+	subscriptionCreateCmd.Flags().StringVar(&subOpts.workbookID, "workbook", "", "id of the workbook to send")
+	subscriptionCreateCmd.MarkFlagRequired("subject")
+	subscriptionCreateCmd.MarkFlagRequired("schedule")
+	subscriptionCreateCmd.MarkFlagRequired("user")
+}
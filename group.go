@@ -0,0 +1,108 @@
+package gotabgo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+// CreateGroup creates a local group, or imports an Active Directory group when
+// group.Import is set.
+func (t *TabApi) CreateGroup(group model.Group) (g *model.Group, err error) {
+	if group.Name == "" {
+		return nil, errors.New("Group name is required")
+	}
+	url := fmt.Sprintf("%s/groups", t.getSiteUrl())
+	group.ID = ""
+	tsRequest := model.TsRequest{Group: &group}
+	tResponse, err := t.send("CreateGroup", http.MethodPost, url, &tsRequest, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Group, nil
+}
+
+// UpdateGroup renames a local group or changes its minimum site role. For an
+// Active Directory group, group.Import carries the domain and license mode.
+func (t *TabApi) UpdateGroup(group model.Group) (g *model.Group, err error) {
+	if group.ID == "" {
+		return nil, errors.New("Group ID is required")
+	}
+	url := fmt.Sprintf("%s/groups/%s", t.getSiteUrl(), group.ID)
+	update := group
+	update.ID = ""
+	update.Domain = nil
+	tsRequest := model.TsRequest{Group: &update}
+	tResponse, err := t.send("UpdateGroup", http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Group, nil
+}
+
+// DeleteGroup removes a group from the current site. Its members stay on the
+// site.
+func (t *TabApi) DeleteGroup(id string) (err error) {
+	url := fmt.Sprintf("%s/groups/%s", t.getSiteUrl(), id)
+	_, err = t.send("DeleteGroup", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
+
+// QueryGroups returns one page of the groups on the current site.
+func (t *TabApi) QueryGroups(opts *QueryOptions) (g []model.Group, page model.Pagination, err error) {
+	url := fmt.Sprintf("%s/groups%s", t.getSiteUrl(), opts.query())
+	return t.queryGroups("QueryGroups", url)
+}
+
+// AddUserToGroup adds an existing site user to a group.
+func (t *TabApi) AddUserToGroup(groupID, userID string) (u *model.User, err error) {
+	url := fmt.Sprintf("%s/groups/%s/users", t.getSiteUrl(), groupID)
+	tsRequest := model.TsRequest{User: &model.User{ID: userID}}
+	tResponse, err := t.send("AddUserToGroup", http.MethodPost, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.User, nil
+}
+
+// RemoveUserFromGroup removes a user from a group without removing them from
+// the site.
+func (t *TabApi) RemoveUserFromGroup(groupID, userID string) (err error) {
+	url := fmt.Sprintf("%s/groups/%s/users/%s", t.getSiteUrl(), groupID, userID)
+	_, err = t.send("RemoveUserFromGroup", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
+
+// GetUsersInGroup returns one page of the members of a group.
+func (t *TabApi) GetUsersInGroup(groupID string, opts *QueryOptions) (u []model.User, page model.Pagination, err error) {
+	url := fmt.Sprintf("%s/groups/%s/users%s", t.getSiteUrl(), groupID, opts.query())
+	tResponse, err := t.send("GetUsersInGroup", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, page, err
+	}
+
+	return tResponse.Users.User, tResponse.Pagination, nil
+}
+
+// GetGroupsForUser returns one page of the groups a user belongs to.
+func (t *TabApi) GetGroupsForUser(userID string, opts *QueryOptions) (g []model.Group, page model.Pagination, err error) {
+	url := fmt.Sprintf("%s/users/%s/groups%s", t.getSiteUrl(), userID, opts.query())
+	return t.queryGroups("GetGroupsForUser", url)
+}
+
+func (t *TabApi) queryGroups(caller, url string) (g []model.Group, page model.Pagination, err error) {
+	tResponse, err := t.send(caller, http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, page, err
+	}
+	if tResponse.Groups != nil {
+		g = tResponse.Groups.Group
+	}
+
+	return g, tResponse.Pagination, nil
+}
@@ -0,0 +1,100 @@
+package gotabgo
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+func TestGroupEndpoints(t *testing.T) {
+	const group = `<group id="g1" name="Analysts" minimumSiteRole="Explorer"/>`
+	runEndpointTests(t, []endpointTest{
+		{
+			name: "CreateGroup", status: http.StatusCreated, resp: group,
+			call: func(api *TabApi) (string, error) {
+				g, err := api.CreateGroup(model.Group{ID: "ignored", Name: "Analysts", MinimumSiteRole: "Explorer"})
+				if err != nil {
+					return "", err
+				}
+				return g.ID, nil
+			},
+			method: http.MethodPost, url: "groups",
+			payload: `<group name="Analysts" minimumSiteRole="Explorer"></group>`, want: "g1",
+		},
+		{
+			name: "UpdateGroup", status: http.StatusOK, resp: group,
+			call: func(api *TabApi) (string, error) {
+				g, err := api.UpdateGroup(model.Group{ID: "g1", Name: "Analysts", Domain: &model.Domain{Name: "local"}})
+				if err != nil {
+					return "", err
+				}
+				return g.MinimumSiteRole, nil
+			},
+			method: http.MethodPut, url: "groups/g1",
+			payload: `<group name="Analysts"></group>`, want: "Explorer",
+		},
+		{
+			name: "DeleteGroup", status: http.StatusNoContent,
+			call: func(api *TabApi) (string, error) {
+				return "", api.DeleteGroup("g1")
+			},
+			method: http.MethodDelete, url: "groups/g1",
+		},
+		{
+			name: "QueryGroups", status: http.StatusOK,
+			resp: `<pagination pageNumber="1" pageSize="100" totalAvailable="2"/><groups>` + group + `<group id="g2" name="All Users"/></groups>`,
+			call: func(api *TabApi) (string, error) {
+				g, page, err := api.QueryGroups(nil)
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprint(g[1].Name, " ", page.TotalAvailable), nil
+			},
+			method: http.MethodGet, url: "groups", want: "All Users 2",
+		},
+		{
+			name: "AddUserToGroup", status: http.StatusOK, resp: `<user id="u1" name="ann"/>`,
+			call: func(api *TabApi) (string, error) {
+				u, err := api.AddUserToGroup("g1", "u1")
+				if err != nil {
+					return "", err
+				}
+				return u.Name, nil
+			},
+			method: http.MethodPost, url: "groups/g1/users",
+			payload: `<user id="u1"></user>`, want: "ann",
+		},
+		{
+			name: "RemoveUserFromGroup", status: http.StatusNoContent,
+			call: func(api *TabApi) (string, error) {
+				return "", api.RemoveUserFromGroup("g1", "u1")
+			},
+			method: http.MethodDelete, url: "groups/g1/users/u1",
+		},
+		{
+			name: "GetUsersInGroup", status: http.StatusOK,
+			resp: `<pagination pageNumber="2" pageSize="1" totalAvailable="2"/><users><user id="u2"/></users>`,
+			call: func(api *TabApi) (string, error) {
+				u, page, err := api.GetUsersInGroup("g1", &QueryOptions{PageSize: 1, PageNumber: 2})
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprint(u[0].ID, " ", page.HasMore()), nil
+			},
+			method: http.MethodGet, url: "groups/g1/users?pageNumber=2&pageSize=1", want: "u2 false",
+		},
+		{
+			name: "GetGroupsForUser", status: http.StatusOK, resp: `<groups>` + group + `</groups>`,
+			call: func(api *TabApi) (string, error) {
+				g, _, err := api.GetGroupsForUser("u1", nil)
+				if err != nil {
+					return "", err
+				}
+				return g[0].ID, nil
+			},
+			method: http.MethodGet, url: "users/u1/groups", want: "g1",
+		},
+	})
+}
@@ -4,7 +4,7 @@ import (
 	"io"
 	"net"
 	"net/http"
-	"strings"
+	"net/url"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -36,38 +36,49 @@ func (c *httpClient) Post(url, contentType string, body io.Reader) (resp *http.R
 	return c.Do(req)
 }
 
-func (c *httpClient) PostWithIP(url, contentType string, body io.Reader) (resp *http.Response, err error) {
-	localIp := GetOutboundIP(url).String()
-	log.WithField("Method", "httpclient.PostWithIP").Debugf("LocalIP: %s", localIp)
-	log.Printf("LocalIP: %s", localIp)
-	req, err := http.NewRequest(http.MethodPost, url, body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("X-FORWARDED-FOR", localIp)
-	req.Header.Set("Content-Type", contentType)
-	return c.Do(req)
-}
-
 func (c *httpClient) Do(req *http.Request) (*http.Response, error) {
 	if c.authToken != "" {
 		req.Header.Add(TABLEAU_AUTH_HEADER, c.authToken)
 	}
-	req.Header.Add("Accept", c.acceptType.String())
+	if req.Header.Get("Accept") == "" {
+		req.Header.Add("Accept", c.acceptType.String())
+	}
 	return c.client.Do(req)
 }
 
+// GetOutboundIP returns the local address used to reach the host of
+// dialAddress, or nil when it can't be determined.
+//
+// Deprecated: use OutboundIP, which reports why the lookup failed.
 func GetOutboundIP(dialAddress string) net.IP {
-	log.WithField("Method", "httpclient.GetOutboundIP").Debugf("outboundURL: %s", dialAddress)
-	splitAddy := strings.Split(dialAddress, "/")
-	conn, err := net.Dial("udp", splitAddy[2]+":80")
+	ip, err := OutboundIP(dialAddress)
 	if err != nil {
-		log.Fatal(err)
+		log.WithField("Method", "httpclient.GetOutboundIP").Error(err)
+		return nil
+	}
+
+	return ip
+}
+
+// OutboundIP returns the local address used to reach the host of
+// dialAddress, for use as the client IP of a trusted ticket request.
+func OutboundIP(dialAddress string) (net.IP, error) {
+	log.WithField("Method", "httpclient.OutboundIP").Debugf("outboundURL: %s", dialAddress)
+	u, err := url.Parse(dialAddress)
+	if err != nil {
+		return nil, err
+	}
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), "80")
+	}
+	conn, err := net.Dial("udp", host)
+	if err != nil {
+		return nil, err
 	}
 	defer conn.Close()
 
 	localAddr := conn.LocalAddr().(*net.UDPAddr)
 
-	return localAddr.IP
+	return localAddr.IP, nil
 }
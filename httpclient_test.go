@@ -0,0 +1,19 @@
+package gotabgo
+
+import "testing"
+
+func TestOutboundIP(t *testing.T) {
+	ip, err := OutboundIP("http://127.0.0.1:8080/trusted")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ip.IsLoopback() {
+		t.Errorf("ip = %s, want a loopback address", ip)
+	}
+	if _, err := OutboundIP("http://[::1"); err == nil {
+		t.Error("expected an error for an unparsable URL")
+	}
+	if ip := GetOutboundIP("http://[::1"); ip != nil {
+		t.Errorf("GetOutboundIP = %s, want nil", ip)
+	}
+}
@@ -0,0 +1,130 @@
+package gotabgo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+const identityPoolApiVer = "3.19"
+
+// CreateIdentityPool creates an identity pool on the server.
+func (t *TabApi) CreateIdentityPool(pool model.IdentityPool) (p *model.IdentityPool, err error) {
+	if err = t.requireApiVersion("CreateIdentityPool", identityPoolApiVer); err != nil {
+		return nil, err
+	}
+	url := fmt.Sprintf("%s/api/%s/identitypools", t.getUrl(), t.ApiVersion)
+	tsRequest := model.TsRequest{IdentityPool: &pool}
+	tResponse, err := t.send("CreateIdentityPool", http.MethodPost, url, &tsRequest, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.IdentityPool, nil
+}
+
+// QueryIdentityPools lists the identity pools on the server.
+func (t *TabApi) QueryIdentityPools() (p []model.IdentityPool, err error) {
+	if err = t.requireApiVersion("QueryIdentityPools", identityPoolApiVer); err != nil {
+		return nil, err
+	}
+	url := fmt.Sprintf("%s/api/%s/identitypools", t.getUrl(), t.ApiVersion)
+	tResponse, err := t.send("QueryIdentityPools", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	if tResponse.IdentityPools != nil {
+		p = tResponse.IdentityPools.IdentityPool
+	}
+
+	return p, nil
+}
+
+// QueryIdentityPool returns the identity pool with the given ID.
+func (t *TabApi) QueryIdentityPool(id string) (p *model.IdentityPool, err error) {
+	if err = t.requireApiVersion("QueryIdentityPool", identityPoolApiVer); err != nil {
+		return nil, err
+	}
+	url := fmt.Sprintf("%s/api/%s/identitypools/%s", t.getUrl(), t.ApiVersion, id)
+	tResponse, err := t.send("QueryIdentityPool", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.IdentityPool, nil
+}
+
+// UpdateIdentityPool changes the identity pool identified by pool.ID.
+func (t *TabApi) UpdateIdentityPool(pool model.IdentityPool) (p *model.IdentityPool, err error) {
+	if err = t.requireApiVersion("UpdateIdentityPool", identityPoolApiVer); err != nil {
+		return nil, err
+	}
+	if pool.ID == "" {
+		return nil, errors.New("Identity Pool ID is required")
+	}
+	url := fmt.Sprintf("%s/api/%s/identitypools/%s", t.getUrl(), t.ApiVersion, pool.ID)
+	update := pool
+	update.ID = ""
+	tsRequest := model.TsRequest{IdentityPool: &update}
+	tResponse, err := t.send("UpdateIdentityPool", http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.IdentityPool, nil
+}
+
+// DeleteIdentityPool removes an identity pool from the server.
+func (t *TabApi) DeleteIdentityPool(id string) (err error) {
+	if err = t.requireApiVersion("DeleteIdentityPool", identityPoolApiVer); err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%s/api/%s/identitypools/%s", t.getUrl(), t.ApiVersion, id)
+	_, err = t.send("DeleteIdentityPool", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
+
+// CreateIdentityStore configures a new identity store on the server.
+func (t *TabApi) CreateIdentityStore(store model.IdentityStore) (s *model.IdentityStore, err error) {
+	if err = t.requireApiVersion("CreateIdentityStore", identityPoolApiVer); err != nil {
+		return nil, err
+	}
+	url := fmt.Sprintf("%s/api/%s/identitystores", t.getUrl(), t.ApiVersion)
+	tsRequest := model.TsRequest{IdentityStore: &store}
+	tResponse, err := t.send("CreateIdentityStore", http.MethodPost, url, &tsRequest, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.IdentityStore, nil
+}
+
+// QueryIdentityStores lists the identity stores configured on the server.
+func (t *TabApi) QueryIdentityStores() (s []model.IdentityStore, err error) {
+	if err = t.requireApiVersion("QueryIdentityStores", identityPoolApiVer); err != nil {
+		return nil, err
+	}
+	url := fmt.Sprintf("%s/api/%s/identitystores", t.getUrl(), t.ApiVersion)
+	tResponse, err := t.send("QueryIdentityStores", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	if tResponse.IdentityStores != nil {
+		s = tResponse.IdentityStores.IdentityStore
+	}
+
+	return s, nil
+}
+
+// DeleteIdentityStore removes an identity store. Identity pools still using
+// it must be deleted first.
+func (t *TabApi) DeleteIdentityStore(id string) (err error) {
+	if err = t.requireApiVersion("DeleteIdentityStore", identityPoolApiVer); err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%s/api/%s/identitystores/%s", t.getUrl(), t.ApiVersion, id)
+	_, err = t.send("DeleteIdentityStore", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
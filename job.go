@@ -0,0 +1,56 @@
+package gotabgo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/groundfoundation/gotabgo/model"
+	log "github.com/sirupsen/logrus"
+)
+
+// QueryJob returns the current state of an asynchronous job.
+func (t *TabApi) QueryJob(id string) (job *model.Job, err error) {
+	url := fmt.Sprintf("%s/jobs/%s", t.getSiteUrl(), id)
+	tResponse, err := t.send("QueryJob", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	if tResponse.Job == nil {
+		return nil, errors.New("Job Not Found on site")
+	}
+
+	return tResponse.Job, nil
+}
+
+// CancelJob asks the server to stop a running job.
+func (t *TabApi) CancelJob(id string) (err error) {
+	url := fmt.Sprintf("%s/jobs/%s", t.getSiteUrl(), id)
+	_, err = t.send("CancelJob", http.MethodPut, url, nil, http.StatusOK)
+	return
+}
+
+// WaitForJob polls a job every interval until it completes or timeout passes.
+// A job that finishes without succeeding is returned along with a *JobError.
+func (t *TabApi) WaitForJob(id string, interval, timeout time.Duration) (job *model.Job, err error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		job, err = t.QueryJob(id)
+		if err != nil {
+			return nil, err
+		}
+		log.WithField("method", "WaitForJob").
+			Debugf("job %s progress %d", id, job.Progress)
+		if job.CompletedAt != "" && job.FinishCode != nil {
+			if *job.FinishCode != model.JobSucceeded {
+				return job, &JobError{job.ID, *job.FinishCode}
+			}
+			return job, nil
+		}
+		if time.Now().Add(interval).After(deadline) {
+			return job, fmt.Errorf("Timed out waiting for job %s", id)
+		}
+		time.Sleep(interval)
+	}
+}
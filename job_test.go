@@ -0,0 +1,78 @@
+package gotabgo
+
+import (
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+// jobStub answers QueryJob for job j1 with the responses in order, repeating
+// the last one once they run out.
+func jobStub(t *testing.T, responses ...string) (*TabApi, *int32) {
+	var calls int32
+	api := newTestApi(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/3.18/sites/site-1/jobs/j1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		n := int(atomic.AddInt32(&calls, 1)) - 1
+		if n >= len(responses) {
+			n = len(responses) - 1
+		}
+		writeXml(w, responses[n])
+	})
+	return api, &calls
+}
+
+func TestWaitForJob(t *testing.T) {
+	api, calls := jobStub(t,
+		`<job id="j1" progress="10"/>`,
+		`<job id="j1" progress="100" finishCode="0" completedAt="2024-01-01T00:00:00Z"/>`,
+	)
+	job, err := api.WaitForJob("j1", time.Millisecond, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Progress != 100 || *job.FinishCode != model.JobSucceeded {
+		t.Errorf("job = %+v", job)
+	}
+	if *calls != 2 {
+		t.Errorf("polled %d times, want 2", *calls)
+	}
+}
+
+func TestWaitForJobFailed(t *testing.T) {
+	api, _ := jobStub(t, `<job id="j1" finishCode="1" completedAt="2024-01-01T00:00:00Z"/>`)
+	job, err := api.WaitForJob("j1", time.Millisecond, time.Second)
+	var jobErr *JobError
+	if !errors.As(err, &jobErr) {
+		t.Fatalf("err = %v, want *JobError", err)
+	}
+	if jobErr.ID != "j1" || jobErr.FinishCode != 1 || job == nil {
+		t.Errorf("err = %+v, job = %+v", jobErr, job)
+	}
+}
+
+func TestWaitForJobTimeout(t *testing.T) {
+	api, _ := jobStub(t, `<job id="j1" progress="50"/>`)
+	job, err := api.WaitForJob("j1", 5*time.Millisecond, 20*time.Millisecond)
+	if err == nil || err.Error() != "Timed out waiting for job j1" {
+		t.Fatalf("err = %v", err)
+	}
+	if job == nil || job.Progress != 50 {
+		t.Errorf("job = %+v", job)
+	}
+}
+
+func TestWaitForJobMissing(t *testing.T) {
+	api, _ := jobStub(t, ``)
+	job, err := api.WaitForJob("j1", time.Millisecond, time.Second)
+	if err == nil || job != nil {
+		t.Fatalf("job = %+v, err = %v", job, err)
+	}
+}
@@ -0,0 +1,163 @@
+package gotabgo
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/groundfoundation/gotabgo/model"
+	log "github.com/sirupsen/logrus"
+)
+
+// License levels a site role consumes
+const (
+	LicenseCreator    = "Creator"
+	LicenseExplorer   = "Explorer"
+	LicenseViewer     = "Viewer"
+	LicenseUnlicensed = "Unlicensed"
+)
+
+// LicenseLevel returns the license a site role consumes.
+func LicenseLevel(siteRole string) string {
+	switch siteRole {
+	case model.SiteRoleCreator, model.SiteRoleSiteAdministratorCreator,
+		model.SiteRoleServerAdministrator:
+		return LicenseCreator
+	case model.SiteRoleExplorer, model.SiteRoleExplorerCanPublish,
+		model.SiteRoleSiteAdministratorExplorer:
+		return LicenseExplorer
+	case model.SiteRoleViewer:
+		return LicenseViewer
+	}
+	return LicenseUnlicensed
+}
+
+// licenseRank orders license levels from none to Creator.
+var licenseRank = map[string]int{
+	LicenseUnlicensed: 0,
+	LicenseViewer:     1,
+	LicenseExplorer:   2,
+	LicenseCreator:    3,
+}
+
+// LicenseCounts is the number of users holding each license level.
+type LicenseCounts struct {
+	Creators   int
+	Explorers  int
+	Viewers    int
+	Unlicensed int
+}
+
+func (c *LicenseCounts) add(level string) {
+	switch level {
+	case LicenseCreator:
+		c.Creators++
+	case LicenseExplorer:
+		c.Explorers++
+	case LicenseViewer:
+		c.Viewers++
+	default:
+		c.Unlicensed++
+	}
+}
+
+// SiteLicenseUsage is the license usage of one site.
+type SiteLicenseUsage struct {
+	Site   model.SiteType
+	Users  []model.User
+	Counts LicenseCounts
+}
+
+// LicensedUser is a user as counted across every site of the server. Level is
+// the highest license the user holds on any site and LastLogin the most
+// recent sign in.
+type LicensedUser struct {
+	Name      string
+	Level     string
+	LastLogin string
+	Sites     []string
+}
+
+// LicenseReport is the license usage of a server, per site and with users
+// on several sites counted once.
+type LicenseReport struct {
+	Sites []SiteLicenseUsage
+	Users []LicensedUser
+	Total LicenseCounts
+}
+
+// LicenseReport collects the users of every site on the server and counts the
+// licenses they consume. It must be called by a server administrator; the
+// session is switched into each site in turn and back to the original site
+// when done.
+func (t *TabApi) LicenseReport() (r *LicenseReport, err error) {
+	sites, err := t.QueryAllSites(nil)
+	if err != nil {
+		return nil, err
+	}
+	// the session is switched back to the site it started on, which has to
+	// be in the listing to know its content URL
+	startID := t.SiteID
+	startUrl, found := "", false
+	for _, site := range sites {
+		if site.ID == startID {
+			startUrl, found = site.ContentUrl, true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("Site Not Found: %s", startID)
+	}
+	defer func() {
+		if t.SiteID != startID {
+			if e := t.SwitchSite(startUrl); e != nil && err == nil {
+				err = e
+			}
+		}
+	}()
+
+	r = &LicenseReport{}
+	byName := make(map[string]*LicensedUser)
+	var names []string
+	for _, site := range sites {
+		if site.ID != t.SiteID {
+			if err = t.SwitchSite(site.ContentUrl); err != nil {
+				return nil, err
+			}
+		}
+		users, e := t.GetAllUsersOnSite(nil)
+		if e != nil {
+			return nil, e
+		}
+		log.WithField("method", "LicenseReport").
+			Debugf("site %s: %d users", site.ContentUrl, len(users))
+
+		usage := SiteLicenseUsage{Site: site, Users: users}
+		for _, u := range users {
+			level := LicenseLevel(u.SiteRole)
+			usage.Counts.add(level)
+
+			key := strings.ToLower(u.Name)
+			lu, ok := byName[key]
+			if !ok {
+				lu = &LicensedUser{Name: u.Name, Level: level}
+				byName[key] = lu
+				names = append(names, key)
+			}
+			if licenseRank[level] > licenseRank[lu.Level] {
+				lu.Level = level
+			}
+			if u.LastLogin > lu.LastLogin {
+				lu.LastLogin = u.LastLogin
+			}
+			lu.Sites = append(lu.Sites, site.ContentUrl)
+		}
+		r.Sites = append(r.Sites, usage)
+	}
+	for _, name := range names {
+		lu := byName[name]
+		r.Users = append(r.Users, *lu)
+		r.Total.add(lu.Level)
+	}
+
+	return r, nil
+}
@@ -0,0 +1,158 @@
+package gotabgo
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+var contentUrlAttr = regexp.MustCompile(`contentUrl="([^"]*)"`)
+
+// licenseStub serves a server with the given sites, keyed by content URL,
+// each listing its users. Sites are listed two to a page and switching sites
+// requires an explicit contentUrl.
+func licenseStub(t *testing.T, siteIDs map[string]string, users map[string]string) *TabApi {
+	current := testSiteID
+	return newTestApi(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/auth/switchSite"):
+			b, _ := ioutil.ReadAll(r.Body)
+			m := contentUrlAttr.FindStringSubmatch(string(b))
+			if m == nil {
+				w.WriteHeader(http.StatusBadRequest)
+				writeXml(w, `<error code="400000"><summary>Bad Request</summary></error>`)
+				return
+			}
+			current = siteIDs[m[1]]
+			writeXml(w, fmt.Sprintf(`<credentials token="t"><site id="%s" contentUrl="%s"/><user id="admin"/></credentials>`, current, m[1]))
+		case strings.HasSuffix(r.URL.Path, "/sites"):
+			var sites []string
+			for _, contentUrl := range []string{"", "sales", "ops"} {
+				if id, ok := siteIDs[contentUrl]; ok {
+					sites = append(sites, fmt.Sprintf(`<site id="%s" contentUrl="%s"/>`, id, contentUrl))
+				}
+			}
+			// two sites to a page, so three sites span two pages
+			pageNumber := 1
+			if r.URL.Query().Get("pageNumber") == "2" {
+				pageNumber = 2
+			}
+			from, to := (pageNumber-1)*2, pageNumber*2
+			if to > len(sites) {
+				to = len(sites)
+			}
+			if from > to {
+				from = to
+			}
+			writeXml(w, fmt.Sprintf(`<pagination pageNumber="%d" pageSize="2" totalAvailable="%d"/><sites>%s</sites>`,
+				pageNumber, len(sites), strings.Join(sites[from:to], "")))
+		case strings.HasSuffix(r.URL.Path, "/sites/"+current+"/users"):
+			writeXml(w, `<pagination pageNumber="1" pageSize="100" totalAvailable="100"/><users>`+users[current]+`</users>`)
+		default:
+			t.Errorf("unexpected request %s %s on site %s", r.Method, r.URL.Path, current)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func TestLicenseReport(t *testing.T) {
+	api := licenseStub(t,
+		map[string]string{"": "default-id", "sales": testSiteID, "ops": "ops-id"},
+		map[string]string{
+			"default-id": `<user name="ann" siteRole="Viewer" lastLogin="2024-01-01T00:00:00Z"/>
+				<user name="admin" siteRole="ServerAdministrator"/>`,
+			testSiteID: `<user name="Ann" siteRole="ExplorerCanPublish" lastLogin="2024-03-01T00:00:00Z"/>
+				<user name="bob" siteRole="Creator"/>
+				<user name="admin" siteRole="ServerAdministrator"/>`,
+			"ops-id": `<user name="carl" siteRole="Viewer"/>
+				<user name="dee" siteRole="Unlicensed"/>
+				<user name="bob" siteRole="Viewer"/>`,
+		})
+
+	r, err := api.LicenseReport()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if api.SiteID != testSiteID {
+		t.Errorf("session left on site %s, want %s", api.SiteID, testSiteID)
+	}
+
+	perSite := map[string]LicenseCounts{
+		"":      {Creators: 1, Viewers: 1},
+		"sales": {Creators: 2, Explorers: 1},
+		"ops":   {Viewers: 2, Unlicensed: 1},
+	}
+	if len(r.Sites) != 3 {
+		t.Fatalf("sites = %d, want 3", len(r.Sites))
+	}
+	for _, s := range r.Sites {
+		if want := perSite[s.Site.ContentUrl]; s.Counts != want {
+			t.Errorf("site %q counts = %+v, want %+v", s.Site.ContentUrl, s.Counts, want)
+		}
+	}
+
+	// ann, admin, bob, carl and dee, each at their highest license
+	if want := (LicenseCounts{Creators: 2, Explorers: 1, Viewers: 1, Unlicensed: 1}); r.Total != want {
+		t.Errorf("total = %+v, want %+v", r.Total, want)
+	}
+	for _, u := range r.Users {
+		if strings.EqualFold(u.Name, "ann") {
+			if u.Level != LicenseExplorer || u.LastLogin != "2024-03-01T00:00:00Z" || len(u.Sites) != 2 {
+				t.Errorf("ann = %+v", u)
+			}
+		}
+	}
+}
+
+func TestLicenseReportStartSiteOnSecondPage(t *testing.T) {
+	api := licenseStub(t,
+		map[string]string{"": "default-id", "sales": "sales-id", "ops": testSiteID},
+		map[string]string{
+			"default-id": `<user name="ann" siteRole="Viewer"/>`,
+			"sales-id":   `<user name="bob" siteRole="Creator"/>`,
+			testSiteID:   `<user name="carl" siteRole="Explorer"/>`,
+		})
+
+	r, err := api.LicenseReport()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Sites) != 3 || r.Sites[2].Site.ContentUrl != "ops" {
+		t.Fatalf("sites = %+v, want all three", r.Sites)
+	}
+	if want := (LicenseCounts{Creators: 1, Explorers: 1, Viewers: 1}); r.Total != want {
+		t.Errorf("total = %+v, want %+v", r.Total, want)
+	}
+	if api.SiteID != testSiteID {
+		t.Errorf("session left on site %s, want %s", api.SiteID, testSiteID)
+	}
+}
+
+func TestLicenseReportStartSiteMissing(t *testing.T) {
+	api := licenseStub(t, map[string]string{"": "default-id"}, nil)
+	if _, err := api.LicenseReport(); err == nil {
+		t.Error("expected an error when the start site is not listed")
+	}
+}
+
+func TestLicenseLevel(t *testing.T) {
+	tests := map[string]string{
+		model.SiteRoleServerAdministrator:       LicenseCreator,
+		model.SiteRoleSiteAdministratorCreator:  LicenseCreator,
+		model.SiteRoleSiteAdministratorExplorer: LicenseExplorer,
+		model.SiteRoleExplorerCanPublish:        LicenseExplorer,
+		model.SiteRoleViewer:                    LicenseViewer,
+		model.SiteRoleUnlicensed:                LicenseUnlicensed,
+		"":                                      LicenseUnlicensed,
+	}
+	for role, want := range tests {
+		if got := LicenseLevel(role); got != want {
+			t.Errorf("LicenseLevel(%q) = %q, want %q", role, got, want)
+		}
+	}
+}
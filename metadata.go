@@ -0,0 +1,198 @@
+package gotabgo
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/groundfoundation/gotabgo/model"
+	log "github.com/sirupsen/logrus"
+)
+
+type metadataRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type metadataResponse struct {
+	Data   json.RawMessage      `json:"data"`
+	Errors []model.GraphQLError `json:"errors"`
+}
+
+// MetadataQuery posts a GraphQL query to the Metadata API with the session's
+// auth token and decodes the data of the response into data. GraphQL errors
+// are returned as a *MetadataError.
+func (t *TabApi) MetadataQuery(query string, variables map[string]interface{}, data interface{}) (err error) {
+	url := fmt.Sprintf("%s/api/metadata/graphql", t.getUrl())
+	payload, err := json.Marshal(metadataRequest{query, variables})
+	if err != nil {
+		return err
+	}
+	log.WithField("method", "MetadataQuery").Debug("payload: ", string(payload))
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", Json.String())
+	req.Header.Set("Accept", Json.String())
+	r, err := t.c.Do(req)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	defer r.Body.Close()
+	if r.StatusCode != http.StatusOK {
+		return &ApiError{r.StatusCode, r.Status}
+	}
+
+	var mr metadataResponse
+	if err = json.NewDecoder(r.Body).Decode(&mr); err != nil {
+		return err
+	}
+	if len(mr.Errors) > 0 {
+		return &MetadataError{mr.Errors}
+	}
+	if data == nil || len(mr.Data) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(mr.Data, data)
+}
+
+// MetadataQueryPages follows the cursor of a paginated Metadata API query.
+// The query must declare an $after variable and select pageInfo { hasNextPage
+// endCursor } and nodes on the top level field named by connection. fn is
+// called with the raw nodes of every page.
+func (t *TabApi) MetadataQueryPages(query string, variables map[string]interface{}, connection string, fn func(nodes json.RawMessage) error) (err error) {
+	vars := make(map[string]interface{}, len(variables)+1)
+	for k, v := range variables {
+		vars[k] = v
+	}
+	for {
+		var data map[string]json.RawMessage
+		if err = t.MetadataQuery(query, vars, &data); err != nil {
+			return err
+		}
+		raw, ok := data[connection]
+		if !ok {
+			return fmt.Errorf("Metadata response has no %s field", connection)
+		}
+		var page struct {
+			Nodes    json.RawMessage `json:"nodes"`
+			PageInfo model.PageInfo  `json:"pageInfo"`
+		}
+		if err = json.Unmarshal(raw, &page); err != nil {
+			return err
+		}
+		if err = fn(page.Nodes); err != nil {
+			return err
+		}
+		if !page.PageInfo.HasNextPage {
+			return nil
+		}
+		if page.PageInfo.EndCursor == "" {
+			return errors.New("Metadata page has more results but no end cursor")
+		}
+		vars["after"] = page.PageInfo.EndCursor
+	}
+}
+
+const workbooksUsingTableQuery = `
+query workbooksUsingTable($name: String, $first: Int, $after: String) {
+  databaseTablesConnection(filter: {name: $name}, first: $first, after: $after) {
+    nodes {
+      downstreamWorkbooks { id luid name projectName owner { luid username } }
+    }
+    pageInfo { hasNextPage endCursor }
+  }
+}`
+
+// WorkbooksUsingTable answers "which workbooks use this database table?",
+// returning each workbook downstream of any table with the given name once.
+func (t *TabApi) WorkbooksUsingTable(name string) (w []model.MetadataWorkbook, err error) {
+	seen := make(map[string]bool)
+	vars := map[string]interface{}{"name": name, "first": 100}
+	err = t.MetadataQueryPages(workbooksUsingTableQuery, vars, "databaseTablesConnection", func(nodes json.RawMessage) error {
+		var tables []struct {
+			DownstreamWorkbooks []model.MetadataWorkbook `json:"downstreamWorkbooks"`
+		}
+		if err := json.Unmarshal(nodes, &tables); err != nil {
+			return err
+		}
+		for _, table := range tables {
+			for _, wb := range table.DownstreamWorkbooks {
+				if !seen[wb.ID] {
+					seen[wb.ID] = true
+					w = append(w, wb)
+				}
+			}
+		}
+		return nil
+	})
+
+	return w, err
+}
+
+const datasourcesUsingTableQuery = `
+query datasourcesUsingTable($name: String, $first: Int, $after: String) {
+  databaseTablesConnection(filter: {name: $name}, first: $first, after: $after) {
+    nodes {
+      downstreamDatasources { id luid name projectName owner { luid username } }
+    }
+    pageInfo { hasNextPage endCursor }
+  }
+}`
+
+// DatasourcesUsingTable returns the published data sources built on any table
+// with the given name.
+func (t *TabApi) DatasourcesUsingTable(name string) (d []model.MetadataDatasource, err error) {
+	seen := make(map[string]bool)
+	vars := map[string]interface{}{"name": name, "first": 100}
+	err = t.MetadataQueryPages(datasourcesUsingTableQuery, vars, "databaseTablesConnection", func(nodes json.RawMessage) error {
+		var tables []struct {
+			DownstreamDatasources []model.MetadataDatasource `json:"downstreamDatasources"`
+		}
+		if err := json.Unmarshal(nodes, &tables); err != nil {
+			return err
+		}
+		for _, table := range tables {
+			for _, ds := range table.DownstreamDatasources {
+				if !seen[ds.ID] {
+					seen[ds.ID] = true
+					d = append(d, ds)
+				}
+			}
+		}
+		return nil
+	})
+
+	return d, err
+}
+
+const upstreamTablesQuery = `
+query upstreamTables($luid: String) {
+  workbooks(filter: {luid: $luid}) {
+    upstreamTables { id luid name fullName schema database { id luid name connectionType } }
+  }
+}`
+
+// UpstreamTables returns the database tables a workbook reads from. The
+// workbook is identified by the LUID used in the REST API.
+func (t *TabApi) UpstreamTables(workbookID string) (tables []model.MetadataTable, err error) {
+	var data struct {
+		Workbooks []struct {
+			UpstreamTables []model.MetadataTable `json:"upstreamTables"`
+		} `json:"workbooks"`
+	}
+	err = t.MetadataQuery(upstreamTablesQuery, map[string]interface{}{"luid": workbookID}, &data)
+	if err != nil {
+		return nil, err
+	}
+	if len(data.Workbooks) == 0 {
+		return nil, errors.New("Workbook Not Found in metadata")
+	}
+
+	return data.Workbooks[0].UpstreamTables, nil
+}
@@ -0,0 +1,74 @@
+package gotabgo
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+// metadataStub serves Metadata API queries, answering each with the
+// response for the $after cursor of the request.
+func metadataStub(t *testing.T, pages map[string]string) *TabApi {
+	return newTestApi(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/metadata/graphql" || r.Method != http.MethodPost {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var req metadataRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+			return
+		}
+		after, _ := req.Variables["after"].(string)
+		w.Header().Set("Content-Type", Json.String())
+		w.Write([]byte(pages[after]))
+	})
+}
+
+func TestMetadataQueryErrors(t *testing.T) {
+	api := metadataStub(t, map[string]string{
+		"": `{"data":null,"errors":[{"message":"Field 'x' is undefined"},{"message":"second"}]}`,
+	})
+	err := api.MetadataQuery("{ x }", nil, nil)
+	merr, ok := err.(*MetadataError)
+	if !ok {
+		t.Fatalf("err = %v, want *MetadataError", err)
+	}
+	if len(merr.Errors) != 2 || merr.Errors[0].Message != "Field 'x' is undefined" {
+		t.Errorf("errors = %v", merr.Errors)
+	}
+	if want := "metadata query failed: Field 'x' is undefined; second"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestWorkbooksUsingTablePages(t *testing.T) {
+	api := metadataStub(t, map[string]string{
+		"": `{"data":{"databaseTablesConnection":{
+			"nodes":[{"downstreamWorkbooks":[{"id":"w1","name":"Sales"},{"id":"w2","name":"Ops"}]}],
+			"pageInfo":{"hasNextPage":true,"endCursor":"c1"}}}}`,
+		"c1": `{"data":{"databaseTablesConnection":{
+			"nodes":[{"downstreamWorkbooks":[{"id":"w2","name":"Ops"},{"id":"w3","name":"HR"}]}],
+			"pageInfo":{"hasNextPage":false,"endCursor":"c2"}}}}`,
+	})
+	w, err := api.WorkbooksUsingTable("orders")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, wb := range w {
+		ids = append(ids, wb.ID)
+	}
+	if len(ids) != 3 || ids[0] != "w1" || ids[1] != "w2" || ids[2] != "w3" {
+		t.Errorf("workbooks = %v, want [w1 w2 w3]", ids)
+	}
+}
+
+func TestMetadataQueryPagesMissingCursor(t *testing.T) {
+	api := metadataStub(t, map[string]string{
+		"": `{"data":{"tables":{"nodes":[],"pageInfo":{"hasNextPage":true,"endCursor":""}}}}`,
+	})
+	err := api.MetadataQueryPages("query", nil, "tables", func(json.RawMessage) error { return nil })
+	if err == nil {
+		t.Error("expected an error for a page without an end cursor")
+	}
+}
@@ -0,0 +1,88 @@
+package gotabgo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+// QueryMetrics returns one page of the metrics on the current site.
+func (t *TabApi) QueryMetrics(opts *QueryOptions) (m []model.Metric, page model.Pagination, err error) {
+	url := fmt.Sprintf("%s/metrics%s", t.getSiteUrl(), opts.query())
+	tResponse, err := t.send("QueryMetrics", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, page, err
+	}
+	if tResponse.Metrics != nil {
+		m = tResponse.Metrics.Metric
+	}
+
+	return m, tResponse.Pagination, nil
+}
+
+// QueryAllMetrics walks every page of QueryMetrics.
+func (t *TabApi) QueryAllMetrics(opts *QueryOptions) (m []model.Metric, err error) {
+	for {
+		metrics, page, err := t.QueryMetrics(opts)
+		if err != nil {
+			return nil, err
+		}
+		m = append(m, metrics...)
+		if len(metrics) == 0 || !page.HasMore() {
+			return m, nil
+		}
+		opts = opts.nextPage(page)
+	}
+}
+
+// GetMetric returns the metric with the given ID.
+func (t *TabApi) GetMetric(id string) (m *model.Metric, err error) {
+	url := fmt.Sprintf("%s/metrics/%s", t.getSiteUrl(), id)
+	tResponse, err := t.send("GetMetric", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Metric, nil
+}
+
+// GetMetricView returns the view a metric was created from.
+func (t *TabApi) GetMetricView(metric *model.Metric) (v *model.View, err error) {
+	if metric.UnderlyingView == nil || metric.UnderlyingView.ID == "" {
+		return nil, fmt.Errorf("Metric %s has no underlying view", metric.ID)
+	}
+
+	return t.GetViewById(metric.UnderlyingView.ID)
+}
+
+// UpdateMetric changes the name, description, project, owner or suspended
+// state of the metric identified by metric.ID.
+func (t *TabApi) UpdateMetric(metric model.Metric) (m *model.Metric, err error) {
+	if metric.ID == "" {
+		return nil, errors.New("Metric ID is required")
+	}
+	url := fmt.Sprintf("%s/metrics/%s", t.getSiteUrl(), metric.ID)
+	update := model.Metric{
+		Name:        metric.Name,
+		Description: metric.Description,
+		Suspended:   metric.Suspended,
+		Project:     metric.Project,
+		Owner:       metric.Owner,
+	}
+	tsRequest := model.TsRequest{Metric: &update}
+	tResponse, err := t.send("UpdateMetric", http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Metric, nil
+}
+
+// DeleteMetric removes a metric. The view it was created from is not affected.
+func (t *TabApi) DeleteMetric(id string) (err error) {
+	url := fmt.Sprintf("%s/metrics/%s", t.getSiteUrl(), id)
+	_, err = t.send("DeleteMetric", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
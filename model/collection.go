@@ -0,0 +1,33 @@
+package model
+
+import "encoding/xml"
+
+// Collection is a user curated list of content from across the site
+type Collection struct {
+	XMLName     xml.Name `json:"-"                      xml:"collection"`
+	ID          string   `json:"id,omitempty"           xml:"id,attr,omitempty"`
+	Name        string   `json:"name,omitempty"         xml:"name,attr,omitempty"`
+	Description string   `json:"description,omitempty"  xml:"description,attr,omitempty"`
+	CreatedAt   string   `json:"createdAt,omitempty"    xml:"createdAt,attr,omitempty"`
+	UpdatedAt   string   `json:"updatedAt,omitempty"    xml:"updatedAt,attr,omitempty"`
+	Owner       *Owner   `json:"owner,omitempty"        xml:"owner,omitempty"`
+}
+
+type Collections struct {
+	XMLName    xml.Name     `json:"-"                     xml:"collections"`
+	Collection []Collection `json:"collection,omitempty"  xml:"collection,omitempty"`
+}
+
+// CollectionItem is a reference to one piece of content in a collection.
+// ContentType takes the Resource constants.
+type CollectionItem struct {
+	XMLName     xml.Name `json:"-"                      xml:"collectionItem"`
+	ContentType Resource `json:"contentType,omitempty"  xml:"contentType,attr,omitempty"`
+	ContentID   string   `json:"contentId,omitempty"    xml:"contentId,attr,omitempty"`
+	Name        string   `json:"name,omitempty"         xml:"name,attr,omitempty"`
+}
+
+type CollectionItems struct {
+	XMLName        xml.Name         `json:"-"                         xml:"collectionItems"`
+	CollectionItem []CollectionItem `json:"collectionItem,omitempty"  xml:"collectionItem,omitempty"`
+}
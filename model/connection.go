@@ -0,0 +1,34 @@
+package model
+
+import "encoding/xml"
+
+// Connection is a data connection embedded in a workbook or data source
+type Connection struct {
+	XMLName             xml.Name    `json:"-"                              xml:"connection"`
+	ID                  string      `json:"id,omitempty"                   xml:"id,attr,omitempty"`
+	Type                string      `json:"type,omitempty"                 xml:"type,attr,omitempty"`
+	ServerAddress       string      `json:"serverAddress,omitempty"        xml:"serverAddress,attr,omitempty"`
+	ServerPort          string      `json:"serverPort,omitempty"           xml:"serverPort,attr,omitempty"`
+	UserName            string      `json:"userName,omitempty"             xml:"userName,attr,omitempty"`
+	Password            string      `json:"password,omitempty"             xml:"password,attr,omitempty"`
+	EmbedPassword       *bool       `json:"embedPassword,omitempty"        xml:"embedPassword,attr,omitempty"`
+	QueryTaggingEnabled *bool       `json:"queryTaggingEnabled,omitempty"  xml:"queryTaggingEnabled,attr,omitempty"`
+	Datasource          *Datasource `json:"datasource,omitempty"           xml:"datasource,omitempty"`
+
+	// The database connections of a virtual connection are listed with
+	// dbClass, server and port instead of the attributes above. Updates to
+	// them still take ServerAddress and ServerPort.
+	DBClass string `json:"dbClass,omitempty"  xml:"dbClass,attr,omitempty"`
+	Server  string `json:"server,omitempty"   xml:"server,attr,omitempty"`
+	Port    string `json:"port,omitempty"     xml:"port,attr,omitempty"`
+}
+
+type Connections struct {
+	XMLName    xml.Name     `json:"-"                     xml:"connections"`
+	Connection []Connection `json:"connection,omitempty"  xml:"connection,omitempty"`
+}
+
+// DataAccelerationConfig turns on precomputing of workbook view data
+type DataAccelerationConfig struct {
+	AccelerationEnabled *bool `json:"accelerationEnabled,omitempty"  xml:"accelerationEnabled,attr,omitempty"`
+}
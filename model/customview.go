@@ -0,0 +1,22 @@
+package model
+
+import "encoding/xml"
+
+// CustomView is a saved set of filters and selections on a shared view
+type CustomView struct {
+	XMLName        xml.Name  `json:"-"                         xml:"customView"`
+	ID             string    `json:"id,omitempty"              xml:"id,attr,omitempty"`
+	Name           string    `json:"name,omitempty"            xml:"name,attr,omitempty"`
+	Shared         *bool     `json:"shared,omitempty"          xml:"shared,attr,omitempty"`
+	CreatedAt      string    `json:"createdAt,omitempty"       xml:"createdAt,attr,omitempty"`
+	UpdatedAt      string    `json:"updatedAt,omitempty"       xml:"updatedAt,attr,omitempty"`
+	LastAccessedAt string    `json:"lastAccessedAt,omitempty"  xml:"lastAccessedAt,attr,omitempty"`
+	View           *View     `json:"view,omitempty"            xml:"view,omitempty"`
+	Workbook       *Workbook `json:"workbook,omitempty"        xml:"workbook,omitempty"`
+	Owner          *Owner    `json:"owner,omitempty"           xml:"owner,omitempty"`
+}
+
+type CustomViews struct {
+	XMLName    xml.Name     `json:"-"                     xml:"customViews"`
+	CustomView []CustomView `json:"customView,omitempty"  xml:"customView,omitempty"`
+}
@@ -0,0 +1,43 @@
+package model
+
+import "encoding/xml"
+
+// DataAlert notifies its recipients when data in a view crosses a threshold
+type DataAlert struct {
+	XMLName    xml.Name    `json:"-"                     xml:"dataAlert"`
+	ID         string      `json:"id,omitempty"          xml:"id,attr,omitempty"`
+	Subject    string      `json:"subject,omitempty"     xml:"subject,attr,omitempty"`
+	CreatorID  string      `json:"creatorId,omitempty"   xml:"creatorId,attr,omitempty"`
+	Frequency  string      `json:"frequency,omitempty"   xml:"frequency,attr,omitempty"`
+	Public     *bool       `json:"public,omitempty"      xml:"public,attr,omitempty"`
+	Suspended  *bool       `json:"suspended,omitempty"   xml:"suspended,attr,omitempty"`
+	CreatedAt  string      `json:"createdAt,omitempty"   xml:"createdAt,attr,omitempty"`
+	UpdatedAt  string      `json:"updatedAt,omitempty"   xml:"updatedAt,attr,omitempty"`
+	Owner      *Owner      `json:"owner,omitempty"       xml:"owner,omitempty"`
+	View       *View       `json:"view,omitempty"        xml:"view,omitempty"`
+	Recipients *Recipients `json:"recipients,omitempty"  xml:"recipients,omitempty"`
+}
+
+type DataAlerts struct {
+	XMLName   xml.Name    `json:"-"                    xml:"dataAlerts"`
+	DataAlert []DataAlert `json:"dataAlert,omitempty"  xml:"dataAlert,omitempty"`
+}
+
+type Recipients struct {
+	Recipient []Recipient `json:"recipient,omitempty"  xml:"recipient,omitempty"`
+}
+
+// Recipient is a user that receives a data alert
+type Recipient struct {
+	ID       string `json:"id,omitempty"        xml:"id,attr,omitempty"`
+	LastSent string `json:"lastSent,omitempty"  xml:"lastSent,attr,omitempty"`
+}
+
+// How often a data alert is checked and sent while its condition holds
+const (
+	AlertFrequencyOnce       = "once"
+	AlertFrequencyFrequently = "frequently"
+	AlertFrequencyHourly     = "hourly"
+	AlertFrequencyDaily      = "daily"
+	AlertFrequencyWeekly     = "weekly"
+)
@@ -0,0 +1,62 @@
+package model
+
+import "encoding/xml"
+
+// Database is a database asset registered in the catalog
+type Database struct {
+	XMLName            xml.Name           `json:"-"                             xml:"database"`
+	ID                 string             `json:"id,omitempty"                  xml:"id,attr,omitempty"`
+	Name               string             `json:"name,omitempty"                xml:"name,attr,omitempty"`
+	Description        string             `json:"description,omitempty"         xml:"description,attr,omitempty"`
+	Type               string             `json:"type,omitempty"                xml:"type,attr,omitempty"`
+	ConnectionType     string             `json:"connectionType,omitempty"      xml:"connectionType,attr,omitempty"`
+	HostName           string             `json:"hostName,omitempty"            xml:"hostName,attr,omitempty"`
+	Port               string             `json:"port,omitempty"                xml:"port,attr,omitempty"`
+	FilePath           string             `json:"filePath,omitempty"            xml:"filePath,attr,omitempty"`
+	IsEmbedded         *bool              `json:"isEmbedded,omitempty"          xml:"isEmbedded,attr,omitempty"`
+	IsCertified        *bool              `json:"isCertified,omitempty"         xml:"isCertified,attr,omitempty"`
+	CertificationNote  string             `json:"certificationNote,omitempty"   xml:"certificationNote,attr,omitempty"`
+	ContentPermissions ContentPermissions `json:"contentPermissions,omitempty"  xml:"contentPermissions,attr,omitempty"`
+	Contact            *Contact           `json:"contact,omitempty"             xml:"contact,omitempty"`
+}
+
+type Databases struct {
+	XMLName  xml.Name   `json:"-"                   xml:"databases"`
+	Database []Database `json:"database,omitempty"  xml:"database,omitempty"`
+}
+
+// Table is a database table asset registered in the catalog
+type Table struct {
+	XMLName           xml.Name `json:"-"                            xml:"table"`
+	ID                string   `json:"id,omitempty"                 xml:"id,attr,omitempty"`
+	Name              string   `json:"name,omitempty"               xml:"name,attr,omitempty"`
+	Description       string   `json:"description,omitempty"        xml:"description,attr,omitempty"`
+	Schema            string   `json:"schema,omitempty"             xml:"schema,attr,omitempty"`
+	IsEmbedded        *bool    `json:"isEmbedded,omitempty"         xml:"isEmbedded,attr,omitempty"`
+	IsCertified       *bool    `json:"isCertified,omitempty"        xml:"isCertified,attr,omitempty"`
+	CertificationNote string   `json:"certificationNote,omitempty"  xml:"certificationNote,attr,omitempty"`
+	Contact           *Contact `json:"contact,omitempty"            xml:"contact,omitempty"`
+}
+
+type Tables struct {
+	XMLName xml.Name `json:"-"                xml:"tables"`
+	Table   []Table  `json:"table,omitempty"  xml:"table,omitempty"`
+}
+
+type Column struct {
+	XMLName     xml.Name `json:"-"                      xml:"column"`
+	ID          string   `json:"id,omitempty"           xml:"id,attr,omitempty"`
+	Name        string   `json:"name,omitempty"         xml:"name,attr,omitempty"`
+	Description string   `json:"description,omitempty"  xml:"description,attr,omitempty"`
+	RemoteType  string   `json:"remoteType,omitempty"   xml:"remoteType,attr,omitempty"`
+}
+
+type Columns struct {
+	XMLName xml.Name `json:"-"                 xml:"columns"`
+	Column  []Column `json:"column,omitempty"  xml:"column,omitempty"`
+}
+
+// Contact is the user responsible for a database or table
+type Contact struct {
+	ID string `json:"id,omitempty"  xml:"id,attr,omitempty"`
+}
@@ -0,0 +1,80 @@
+package model
+
+import "encoding/xml"
+
+// DataQualityWarning is shown to users of a data source, database, table or
+// flow and its downstream content
+type DataQualityWarning struct {
+	XMLName         xml.Name  `json:"-"                          xml:"dataQualityWarning"`
+	ID              string    `json:"id,omitempty"               xml:"id,attr,omitempty"`
+	Type            string    `json:"type,omitempty"             xml:"type,attr,omitempty"`
+	Message         string    `json:"message,omitempty"          xml:"message,attr,omitempty"`
+	IsActive        *bool     `json:"isActive,omitempty"         xml:"isActive,attr,omitempty"`
+	IsSevere        *bool     `json:"isSevere,omitempty"         xml:"isSevere,attr,omitempty"`
+	ContentID       string    `json:"contentId,omitempty"        xml:"contentId,attr,omitempty"`
+	ContentType     AssetType `json:"contentType,omitempty"      xml:"contentType,attr,omitempty"`
+	UserDisplayName string    `json:"userDisplayName,omitempty"  xml:"userDisplayName,attr,omitempty"`
+	CreatedAt       string    `json:"createdAt,omitempty"        xml:"createdAt,attr,omitempty"`
+	UpdatedAt       string    `json:"updatedAt,omitempty"        xml:"updatedAt,attr,omitempty"`
+	Owner           *Owner    `json:"owner,omitempty"            xml:"owner,omitempty"`
+}
+
+type DataQualityWarningList struct {
+	XMLName            xml.Name             `json:"-"                             xml:"dataQualityWarningList"`
+	DataQualityWarning []DataQualityWarning `json:"dataQualityWarning,omitempty"  xml:"dataQualityWarning,omitempty"`
+}
+
+// Data quality warning types
+const (
+	WarningDeprecated       = "Deprecated"
+	WarningWarning          = "Warning"
+	WarningStaleData        = "Stale data"
+	WarningUnderMaintenance = "Under maintenance"
+	WarningSensitiveData    = "Sensitive data"
+)
+
+// Label is a data quality warning or certification attached to an asset
+// through the labels API
+type Label struct {
+	XMLName         xml.Name  `json:"-"                          xml:"label"`
+	ID              string    `json:"id,omitempty"               xml:"id,attr,omitempty"`
+	Value           string    `json:"value,omitempty"            xml:"value,attr,omitempty"`
+	Category        string    `json:"category,omitempty"         xml:"category,attr,omitempty"`
+	Message         string    `json:"message,omitempty"          xml:"message,attr,omitempty"`
+	Active          *bool     `json:"active,omitempty"           xml:"active,attr,omitempty"`
+	Elevated        *bool     `json:"elevated,omitempty"         xml:"elevated,attr,omitempty"`
+	ContentID       string    `json:"contentId,omitempty"        xml:"contentId,attr,omitempty"`
+	ContentType     AssetType `json:"contentType,omitempty"      xml:"contentType,attr,omitempty"`
+	UserDisplayName string    `json:"userDisplayName,omitempty"  xml:"userDisplayName,attr,omitempty"`
+	CreatedAt       string    `json:"createdAt,omitempty"        xml:"createdAt,attr,omitempty"`
+	UpdatedAt       string    `json:"updatedAt,omitempty"        xml:"updatedAt,attr,omitempty"`
+	Owner           *Owner    `json:"owner,omitempty"            xml:"owner,omitempty"`
+}
+
+type LabelList struct {
+	XMLName xml.Name `json:"-"                xml:"labelList"`
+	Label   []Label  `json:"label,omitempty"  xml:"label,omitempty"`
+}
+
+// ContentList names the assets a labels request applies to
+type ContentList struct {
+	XMLName xml.Name       `json:"-"                  xml:"contentList"`
+	Content []AssetContent `json:"content,omitempty"  xml:"content,omitempty"`
+}
+
+type AssetContent struct {
+	ContentType AssetType `json:"contentType"  xml:"contentType,attr"`
+	ContentID   string    `json:"contentId"    xml:"contentId,attr"`
+}
+
+// AssetType is the kind of asset a data quality warning or label is attached
+// to, spelled the way the API expects it in URLs and payloads.
+type AssetType string
+
+const (
+	AssetDatasource        AssetType = "datasource"
+	AssetDatabase          AssetType = "database"
+	AssetTable             AssetType = "table"
+	AssetFlow              AssetType = "flow"
+	AssetVirtualConnection AssetType = "virtualconnection"
+)
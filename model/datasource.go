@@ -0,0 +1,23 @@
+package model
+
+import "encoding/xml"
+
+type Datasource struct {
+	XMLName     xml.Name `json:"-"                      xml:"datasource"`
+	ID          string   `json:"id,omitempty"           xml:"id,attr,omitempty"`
+	Name        string   `json:"name,omitempty"         xml:"name,attr,omitempty"`
+	Description string   `json:"description,omitempty"  xml:"description,attr,omitempty"`
+	ContentUrl  string   `json:"contentUrl,omitempty"   xml:"contentUrl,attr,omitempty"`
+	Type        string   `json:"type,omitempty"         xml:"type,attr,omitempty"`
+	IsCertified *bool    `json:"isCertified,omitempty"  xml:"isCertified,attr,omitempty"`
+	CreatedAt   string   `json:"createdAt,omitempty"    xml:"createdAt,attr,omitempty"`
+	UpdatedAt   string   `json:"updatedAt,omitempty"    xml:"updatedAt,attr,omitempty"`
+	Project     *Project `json:"project,omitempty"      xml:"project,omitempty"`
+	Owner       *Owner   `json:"owner,omitempty"        xml:"owner,omitempty"`
+	Tags        *Tags    `json:"tags,omitempty"         xml:"tags,omitempty"`
+}
+
+type Datasources struct {
+	XMLName    xml.Name     `json:"-"                      xml:"datasources"`
+	Datasource []Datasource `json:"datasource,omitempty"   xml:"datasource,omitempty"`
+}
@@ -0,0 +1,40 @@
+package model
+
+import "encoding/xml"
+
+// Flow is a Tableau Prep flow published to the site
+type Flow struct {
+	XMLName     xml.Name `json:"-"                      xml:"flow"`
+	ID          string   `json:"id,omitempty"           xml:"id,attr,omitempty"`
+	Name        string   `json:"name,omitempty"         xml:"name,attr,omitempty"`
+	Description string   `json:"description,omitempty"  xml:"description,attr,omitempty"`
+	WebPageUrl  string   `json:"webpageUrl,omitempty"   xml:"webpageUrl,attr,omitempty"`
+	FileType    string   `json:"fileType,omitempty"     xml:"fileType,attr,omitempty"`
+	CreatedAt   string   `json:"createdAt,omitempty"    xml:"createdAt,attr,omitempty"`
+	UpdatedAt   string   `json:"updatedAt,omitempty"    xml:"updatedAt,attr,omitempty"`
+	Project     *Project `json:"project,omitempty"      xml:"project,omitempty"`
+	Owner       *Owner   `json:"owner,omitempty"        xml:"owner,omitempty"`
+	Tags        *Tags    `json:"tags,omitempty"         xml:"tags,omitempty"`
+}
+
+type Flows struct {
+	XMLName xml.Name `json:"-"               xml:"flows"`
+	Flow    []Flow   `json:"flow,omitempty"  xml:"flow,omitempty"`
+}
+
+// FlowRun is one execution of a flow
+type FlowRun struct {
+	XMLName         xml.Name `json:"-"                          xml:"flowRun"`
+	ID              string   `json:"id,omitempty"               xml:"id,attr,omitempty"`
+	FlowID          string   `json:"flowId,omitempty"           xml:"flowId,attr,omitempty"`
+	Status          string   `json:"status,omitempty"           xml:"status,attr,omitempty"`
+	Progress        string   `json:"progress,omitempty"         xml:"progress,attr,omitempty"`
+	StartedAt       string   `json:"startedAt,omitempty"        xml:"startedAt,attr,omitempty"`
+	CompletedAt     string   `json:"completedAt,omitempty"      xml:"completedAt,attr,omitempty"`
+	BackgroundJobID string   `json:"backgroundJobId,omitempty"  xml:"backgroundJobId,attr,omitempty"`
+}
+
+type FlowRuns struct {
+	XMLName xml.Name  `json:"-"                  xml:"flowRuns"`
+	FlowRun []FlowRun `json:"flowRun,omitempty"  xml:"flowRun,omitempty"`
+}
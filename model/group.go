@@ -0,0 +1,39 @@
+package model
+
+import "encoding/xml"
+
+type Group struct {
+	XMLName         xml.Name `json:"-"                          xml:"group"`
+	ID              string   `json:"id,omitempty"               xml:"id,attr,omitempty"`
+	Name            string   `json:"name,omitempty"             xml:"name,attr,omitempty"`
+	MinimumSiteRole string   `json:"minimumSiteRole,omitempty"  xml:"minimumSiteRole,attr,omitempty"`
+	Domain          *Domain  `json:"domain,omitempty"           xml:"domain,omitempty"`
+	Import          *Import  `json:"import,omitempty"           xml:"import,omitempty"`
+}
+
+type Groups struct {
+	XMLName xml.Name `json:"-"                 xml:"groups"`
+	Group   []Group  `json:"group,omitempty"   xml:"group,omitempty"`
+}
+
+type Domain struct {
+	Name string `json:"name,omitempty"  xml:"name,attr,omitempty"`
+}
+
+// Import describes the Active Directory group a server group is synchronized
+// with.
+type Import struct {
+	Source           string `json:"source,omitempty"            xml:"source,attr,omitempty"`
+	DomainName       string `json:"domainName,omitempty"        xml:"domainName,attr,omitempty"`
+	GrantLicenseMode string `json:"grantLicenseMode,omitempty"  xml:"grantLicenseMode,attr,omitempty"`
+	SiteRole         string `json:"siteRole,omitempty"          xml:"siteRole,attr,omitempty"`
+}
+
+const (
+	ImportSourceActiveDirectory = "ActiveDirectory"
+
+	// GrantLicenseOnLogin assigns the group's site role when a member signs in
+	GrantLicenseOnLogin = "onLogin"
+	// GrantLicenseOnSync assigns the group's site role when the group is synchronized
+	GrantLicenseOnSync = "onSync"
+)
@@ -0,0 +1,46 @@
+package model
+
+import "encoding/xml"
+
+// IdentityPool groups users who sign in with the same identity store and
+// authentication type
+type IdentityPool struct {
+	XMLName       xml.Name       `json:"-"                        xml:"identityPool"`
+	ID            string         `json:"id,omitempty"             xml:"id,attr,omitempty"`
+	Name          string         `json:"name,omitempty"           xml:"name,attr,omitempty"`
+	Description   string         `json:"description,omitempty"    xml:"description,attr,omitempty"`
+	IsDefault     *bool          `json:"isDefault,omitempty"      xml:"isDefault,attr,omitempty"`
+	IdentityStore *IdentityStore `json:"identityStore,omitempty"  xml:"identityStore,omitempty"`
+	AuthType      *AuthType      `json:"authType,omitempty"       xml:"authType,omitempty"`
+}
+
+type IdentityPools struct {
+	XMLName      xml.Name       `json:"-"                       xml:"identityPools"`
+	IdentityPool []IdentityPool `json:"identityPool,omitempty"  xml:"identityPool,omitempty"`
+}
+
+// IdentityStore is the directory users of an identity pool are looked up in
+type IdentityStore struct {
+	XMLName     xml.Name `json:"-"                      xml:"identityStore"`
+	ID          string   `json:"id,omitempty"           xml:"id,attr,omitempty"`
+	Type        string   `json:"type,omitempty"         xml:"type,attr,omitempty"`
+	DisplayName string   `json:"displayName,omitempty"  xml:"displayName,attr,omitempty"`
+	InstanceID  string   `json:"instanceId,omitempty"   xml:"instanceId,attr,omitempty"`
+}
+
+type IdentityStores struct {
+	XMLName       xml.Name        `json:"-"                        xml:"identityStores"`
+	IdentityStore []IdentityStore `json:"identityStore,omitempty"  xml:"identityStore,omitempty"`
+}
+
+// AuthType is the authentication an identity pool signs users in with
+type AuthType struct {
+	Type       string `json:"type,omitempty"        xml:"type,attr,omitempty"`
+	InstanceID string `json:"instanceId,omitempty"  xml:"instanceId,attr,omitempty"`
+}
+
+// Identity store types
+const (
+	IdentityStoreLocal = "local"
+	IdentityStoreLDAP  = "ldap"
+)
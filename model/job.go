@@ -0,0 +1,24 @@
+package model
+
+import "encoding/xml"
+
+// Job is an asynchronous server task such as an extract refresh or flow run
+type Job struct {
+	XMLName     xml.Name `json:"-"                      xml:"job"`
+	ID          string   `json:"id,omitempty"           xml:"id,attr,omitempty"`
+	Mode        string   `json:"mode,omitempty"         xml:"mode,attr,omitempty"`
+	Type        string   `json:"type,omitempty"         xml:"type,attr,omitempty"`
+	Progress    int      `json:"progress,omitempty"     xml:"progress,attr,omitempty"`
+	FinishCode  *int     `json:"finishCode,omitempty"   xml:"finishCode,attr,omitempty"`
+	CreatedAt   string   `json:"createdAt,omitempty"    xml:"createdAt,attr,omitempty"`
+	StartedAt   string   `json:"startedAt,omitempty"    xml:"startedAt,attr,omitempty"`
+	CompletedAt string   `json:"completedAt,omitempty"  xml:"completedAt,attr,omitempty"`
+	FlowRun     *FlowRun `json:"flowRun,omitempty"      xml:"flowRun,omitempty"`
+}
+
+// Finish codes reported by a completed job
+const (
+	JobSucceeded = 0
+	JobFailed    = 1
+	JobCancelled = 2
+)
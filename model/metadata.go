@@ -0,0 +1,53 @@
+package model
+
+// Types returned by the Metadata API. The Metadata API only speaks JSON, so
+// these carry no XML tags.
+
+// PageInfo is the cursor state of a Metadata API connection
+type PageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+type MetadataDatabase struct {
+	ID             string `json:"id,omitempty"`
+	Luid           string `json:"luid,omitempty"`
+	Name           string `json:"name,omitempty"`
+	ConnectionType string `json:"connectionType,omitempty"`
+}
+
+type MetadataTable struct {
+	ID       string            `json:"id,omitempty"`
+	Luid     string            `json:"luid,omitempty"`
+	Name     string            `json:"name,omitempty"`
+	FullName string            `json:"fullName,omitempty"`
+	Schema   string            `json:"schema,omitempty"`
+	Database *MetadataDatabase `json:"database,omitempty"`
+}
+
+type MetadataWorkbook struct {
+	ID          string         `json:"id,omitempty"`
+	Luid        string         `json:"luid,omitempty"`
+	Name        string         `json:"name,omitempty"`
+	ProjectName string         `json:"projectName,omitempty"`
+	Owner       *MetadataOwner `json:"owner,omitempty"`
+}
+
+type MetadataDatasource struct {
+	ID          string         `json:"id,omitempty"`
+	Luid        string         `json:"luid,omitempty"`
+	Name        string         `json:"name,omitempty"`
+	ProjectName string         `json:"projectName,omitempty"`
+	Owner       *MetadataOwner `json:"owner,omitempty"`
+}
+
+type MetadataOwner struct {
+	Luid     string `json:"luid,omitempty"`
+	Username string `json:"username,omitempty"`
+}
+
+// GraphQLError is an entry in the errors list of a Metadata API response
+type GraphQLError struct {
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path,omitempty"`
+}
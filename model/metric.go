@@ -0,0 +1,29 @@
+package model
+
+import "encoding/xml"
+
+// Metric tracks a single value of a view over time
+type Metric struct {
+	XMLName        xml.Name    `json:"-"                         xml:"metric"`
+	ID             string      `json:"id,omitempty"              xml:"id,attr,omitempty"`
+	Name           string      `json:"name,omitempty"            xml:"name,attr,omitempty"`
+	Description    string      `json:"description,omitempty"     xml:"description,attr,omitempty"`
+	WebPageUrl     string      `json:"webpageUrl,omitempty"      xml:"webpageUrl,attr,omitempty"`
+	CreatedAt      string      `json:"createdAt,omitempty"       xml:"createdAt,attr,omitempty"`
+	UpdatedAt      string      `json:"updatedAt,omitempty"       xml:"updatedAt,attr,omitempty"`
+	Suspended      *bool       `json:"suspended,omitempty"       xml:"suspended,attr,omitempty"`
+	Project        *Project    `json:"project,omitempty"         xml:"project,omitempty"`
+	Owner          *Owner      `json:"owner,omitempty"           xml:"owner,omitempty"`
+	Tags           *Tags       `json:"tags,omitempty"            xml:"tags,omitempty"`
+	UnderlyingView *MetricView `json:"underlyingView,omitempty"  xml:"underlyingView,omitempty"`
+}
+
+// MetricView identifies the view a metric was created from
+type MetricView struct {
+	ID string `json:"id,omitempty"  xml:"id,attr,omitempty"`
+}
+
+type Metrics struct {
+	XMLName xml.Name `json:"-"                 xml:"metrics"`
+	Metric  []Metric `json:"metric,omitempty"  xml:"metric,omitempty"`
+}
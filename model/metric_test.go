@@ -0,0 +1,46 @@
+package model
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestMetricXml(t *testing.T) {
+	in := `<tsResponse xmlns="http://tableau.com/api">
+  <pagination pageNumber="1" pageSize="100" totalAvailable="1"/>
+  <metrics>
+    <metric id="m1" name="Revenue" description="Daily revenue" suspended="false">
+      <project id="p1" name="Finance"/>
+      <owner id="u1"/>
+      <tags><tag label="kpi"/></tags>
+      <underlyingView id="v1"/>
+    </metric>
+  </metrics>
+</tsResponse>`
+	var r TsResponse
+	if err := xml.Unmarshal([]byte(in), &r); err != nil {
+		t.Fatal(err)
+	}
+	if r.Metrics == nil || len(r.Metrics.Metric) != 1 {
+		t.Fatalf("metrics = %+v", r.Metrics)
+	}
+	m := r.Metrics.Metric[0]
+	if m.ID != "m1" || m.Name != "Revenue" || m.Suspended == nil || *m.Suspended {
+		t.Errorf("metric = %+v", m)
+	}
+	if m.UnderlyingView == nil || m.UnderlyingView.ID != "v1" {
+		t.Errorf("underlying view = %+v", m.UnderlyingView)
+	}
+	if m.Project == nil || m.Project.ID != "p1" || m.Owner == nil || m.Owner.ID != "u1" {
+		t.Errorf("project = %+v, owner = %+v", m.Project, m.Owner)
+	}
+
+	out, err := xml.Marshal(TsRequest{Metric: &Metric{Name: "Revenue", UnderlyingView: m.UnderlyingView}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(out), `<metric name="Revenue"><underlyingView id="v1"></underlyingView></metric>`) {
+		t.Errorf("marshalled %s", out)
+	}
+}
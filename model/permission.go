@@ -0,0 +1,82 @@
+package model
+
+import "encoding/xml"
+
+// Permissions lists the capabilities granted on a piece of content, or the
+// default capabilities of a project for one content type.
+type Permissions struct {
+	XMLName             xml.Name              `json:"-"                              xml:"permissions"`
+	Project             *Project              `json:"project,omitempty"              xml:"project,omitempty"`
+	Workbook            *Workbook             `json:"workbook,omitempty"             xml:"workbook,omitempty"`
+	View                *View                 `json:"view,omitempty"                 xml:"view,omitempty"`
+	Datasource          *Datasource           `json:"datasource,omitempty"           xml:"datasource,omitempty"`
+	Flow                *Flow                 `json:"flow,omitempty"                 xml:"flow,omitempty"`
+	Database            *Database             `json:"database,omitempty"             xml:"database,omitempty"`
+	Table               *Table                `json:"table,omitempty"                xml:"table,omitempty"`
+	GranteeCapabilities []GranteeCapabilities `json:"granteeCapabilities,omitempty"  xml:"granteeCapabilities,omitempty"`
+}
+
+// GranteeCapabilities is the set of capabilities granted to one user or group.
+// Exactly one of User and Group is set.
+type GranteeCapabilities struct {
+	User         *User        `json:"user,omitempty"          xml:"user,omitempty"`
+	Group        *Group       `json:"group,omitempty"         xml:"group,omitempty"`
+	Capabilities Capabilities `json:"capabilities"            xml:"capabilities"`
+}
+
+type Capabilities struct {
+	Capability []Capability `json:"capability,omitempty"  xml:"capability,omitempty"`
+}
+
+type Capability struct {
+	Name CapabilityName `json:"name"  xml:"name,attr"`
+	Mode CapabilityMode `json:"mode"  xml:"mode,attr"`
+}
+
+type CapabilityName string
+
+const (
+	CapabilityAddComment             CapabilityName = "AddComment"
+	CapabilityChangeHierarchy        CapabilityName = "ChangeHierarchy"
+	CapabilityChangePermissions      CapabilityName = "ChangePermissions"
+	CapabilityConnect                CapabilityName = "Connect"
+	CapabilityCreateRefreshMetrics   CapabilityName = "CreateRefreshMetrics"
+	CapabilityDelete                 CapabilityName = "Delete"
+	CapabilityExecute                CapabilityName = "Execute"
+	CapabilityExportData             CapabilityName = "ExportData"
+	CapabilityExportImage            CapabilityName = "ExportImage"
+	CapabilityExportXml              CapabilityName = "ExportXml"
+	CapabilityFilter                 CapabilityName = "Filter"
+	CapabilityInheritedProjectLeader CapabilityName = "InheritedProjectLeader"
+	CapabilityProjectLeader          CapabilityName = "ProjectLeader"
+	CapabilityRead                   CapabilityName = "Read"
+	CapabilityRunExplainData         CapabilityName = "RunExplainData"
+	CapabilitySaveAs                 CapabilityName = "SaveAs"
+	CapabilityShareView              CapabilityName = "ShareView"
+	CapabilityViewComments           CapabilityName = "ViewComments"
+	CapabilityViewUnderlyingData     CapabilityName = "ViewUnderlyingData"
+	CapabilityWebAuthoring           CapabilityName = "WebAuthoring"
+	CapabilityWrite                  CapabilityName = "Write"
+)
+
+type CapabilityMode string
+
+const (
+	Allow CapabilityMode = "Allow"
+	Deny  CapabilityMode = "Deny"
+)
+
+// Resource is the kind of content addressed by a REST endpoint, spelled the
+// way it appears in the URL.
+type Resource string
+
+const (
+	ResourceProjects           Resource = "projects"
+	ResourceWorkbooks          Resource = "workbooks"
+	ResourceViews              Resource = "views"
+	ResourceDatasources        Resource = "datasources"
+	ResourceFlows              Resource = "flows"
+	ResourceDatabases          Resource = "databases"
+	ResourceTables             Resource = "tables"
+	ResourceVirtualConnections Resource = "virtualconnections"
+)
@@ -0,0 +1,24 @@
+package model
+
+import "encoding/xml"
+
+// Revision is one published version of a workbook or data source
+type Revision struct {
+	XMLName        xml.Name   `json:"-"                         xml:"revision"`
+	RevisionNumber string     `json:"revisionNumber,omitempty"  xml:"revisionNumber,attr,omitempty"`
+	PublishedAt    string     `json:"publishedAt,omitempty"     xml:"publishedAt,attr,omitempty"`
+	Deleted        bool       `json:"deleted"                   xml:"deleted,attr"`
+	Current        bool       `json:"current"                   xml:"current,attr"`
+	SizeInBytes    string     `json:"sizeInBytes,omitempty"     xml:"sizeInBytes,attr,omitempty"`
+	Publisher      *Publisher `json:"publisher,omitempty"     xml:"publisher,omitempty"`
+}
+
+type Revisions struct {
+	XMLName  xml.Name   `json:"-"                   xml:"revisions"`
+	Revision []Revision `json:"revision,omitempty"  xml:"revision,omitempty"`
+}
+
+type Publisher struct {
+	ID   string `json:"id,omitempty"    xml:"id,attr,omitempty"`
+	Name string `json:"name,omitempty"  xml:"name,attr,omitempty"`
+}
@@ -0,0 +1,86 @@
+package model
+
+import "encoding/xml"
+
+type Schedule struct {
+	XMLName          xml.Name          `json:"-"                           xml:"schedule"`
+	ID               string            `json:"id,omitempty"                xml:"id,attr,omitempty"`
+	Name             string            `json:"name,omitempty"              xml:"name,attr,omitempty"`
+	State            string            `json:"state,omitempty"             xml:"state,attr,omitempty"`
+	Priority         int               `json:"priority,omitempty"          xml:"priority,attr,omitempty"`
+	Type             string            `json:"type,omitempty"              xml:"type,attr,omitempty"`
+	Frequency        string            `json:"frequency,omitempty"         xml:"frequency,attr,omitempty"`
+	ExecutionOrder   string            `json:"executionOrder,omitempty"    xml:"executionOrder,attr,omitempty"`
+	CreatedAt        string            `json:"createdAt,omitempty"         xml:"createdAt,attr,omitempty"`
+	UpdatedAt        string            `json:"updatedAt,omitempty"         xml:"updatedAt,attr,omitempty"`
+	NextRunAt        string            `json:"nextRunAt,omitempty"         xml:"nextRunAt,attr,omitempty"`
+	EndScheduleAt    string            `json:"endScheduleAt,omitempty"     xml:"endScheduleAt,attr,omitempty"`
+	FrequencyDetails *FrequencyDetails `json:"frequencyDetails,omitempty"  xml:"frequencyDetails,omitempty"`
+}
+
+type Schedules struct {
+	XMLName  xml.Name   `json:"-"                   xml:"schedules"`
+	Schedule []Schedule `json:"schedule,omitempty"  xml:"schedule,omitempty"`
+}
+
+// FrequencyDetails sets when a schedule runs. Start and End are times of day
+// such as "18:30:00"; End is only used by hourly schedules.
+type FrequencyDetails struct {
+	Start     string    `json:"start,omitempty"      xml:"start,attr,omitempty"`
+	End       string    `json:"end,omitempty"        xml:"end,attr,omitempty"`
+	Intervals Intervals `json:"intervals"            xml:"intervals"`
+}
+
+type Intervals struct {
+	Interval []Interval `json:"interval,omitempty"  xml:"interval,omitempty"`
+}
+
+// Interval is one repetition rule of a schedule. Hourly schedules set Hours
+// or Minutes, weekly schedules set one WeekDay per interval and monthly
+// schedules set MonthDay.
+type Interval struct {
+	Hours    string `json:"hours,omitempty"     xml:"hours,attr,omitempty"`
+	Minutes  string `json:"minutes,omitempty"   xml:"minutes,attr,omitempty"`
+	WeekDay  string `json:"weekDay,omitempty"   xml:"weekDay,attr,omitempty"`
+	MonthDay string `json:"monthDay,omitempty"  xml:"monthDay,attr,omitempty"`
+}
+
+// Schedule types, frequencies, execution orders and states
+const (
+	ScheduleTypeExtract      = "Extract"
+	ScheduleTypeFlow         = "Flow"
+	ScheduleTypeSubscription = "Subscription"
+
+	FrequencyHourly  = "Hourly"
+	FrequencyDaily   = "Daily"
+	FrequencyWeekly  = "Weekly"
+	FrequencyMonthly = "Monthly"
+
+	ExecutionParallel = "Parallel"
+	ExecutionSerial   = "Serial"
+
+	ScheduleStateActive    = "Active"
+	ScheduleStateSuspended = "Suspended"
+)
+
+type Task struct {
+	XMLName        xml.Name        `json:"-"                         xml:"task"`
+	ExtractRefresh *ExtractRefresh `json:"extractRefresh,omitempty"  xml:"extractRefresh,omitempty"`
+}
+
+type Tasks struct {
+	XMLName xml.Name `json:"-"               xml:"tasks"`
+	Task    []Task   `json:"task,omitempty"  xml:"task,omitempty"`
+}
+
+// ExtractRefresh is a task that refreshes the extract of a workbook or data
+// source on a schedule.
+type ExtractRefresh struct {
+	ID                     string      `json:"id,omitempty"                      xml:"id,attr,omitempty"`
+	Priority               int         `json:"priority,omitempty"                xml:"priority,attr,omitempty"`
+	ConsecutiveFailedCount int         `json:"consecutiveFailedCount,omitempty"  xml:"consecutiveFailedCount,attr,omitempty"`
+	Type                   string      `json:"type,omitempty"                    xml:"type,attr,omitempty"`
+	Schedule               *Schedule   `json:"schedule,omitempty"                xml:"schedule,omitempty"`
+	Workbook               *Workbook   `json:"workbook,omitempty"                xml:"workbook,omitempty"`
+	Datasource             *Datasource `json:"datasource,omitempty"              xml:"datasource,omitempty"`
+}
@@ -0,0 +1,125 @@
+package model
+
+// Types returned by the content search endpoint, which only speaks JSON.
+
+// SearchResults is one page of content search hits
+type SearchResults struct {
+	Total int          `json:"total"`
+	Next  string       `json:"next,omitempty"`
+	Prev  string       `json:"prev,omitempty"`
+	Limit int          `json:"limit,omitempty"`
+	Items []SearchItem `json:"items"`
+}
+
+// SearchItem is a single hit. Its Type says which of AsWorkbook, AsView and
+// AsDatasource will succeed.
+type SearchItem struct {
+	URI     string        `json:"uri,omitempty"`
+	Type    string        `json:"type"`
+	Content SearchContent `json:"content"`
+}
+
+// SearchContent holds the fields of a hit. Which are set depends on the
+// content type.
+type SearchContent struct {
+	Type         string   `json:"type,omitempty"`
+	Luid         string   `json:"luid,omitempty"`
+	Title        string   `json:"title,omitempty"`
+	Description  string   `json:"description,omitempty"`
+	OwnerID      string   `json:"ownerId,omitempty"`
+	OwnerName    string   `json:"ownerName,omitempty"`
+	ProjectID    string   `json:"projectId,omitempty"`
+	ProjectName  string   `json:"projectName,omitempty"`
+	WorkbookID   string   `json:"workbookId,omitempty"`
+	WorkbookName string   `json:"workbookName,omitempty"`
+	SheetType    string   `json:"sheetType,omitempty"`
+	ContentUrl   string   `json:"path,omitempty"`
+	HitsTotal    int      `json:"hitsTotal,omitempty"`
+	ModifiedTime string   `json:"modifiedTime,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+}
+
+// Content types that can be searched for
+const (
+	SearchTypeWorkbook   = "workbook"
+	SearchTypeView       = "view"
+	SearchTypeDatasource = "datasource"
+	SearchTypeFlow       = "flow"
+	SearchTypeProject    = "project"
+)
+
+// AsWorkbook returns the hit as a workbook when it is one.
+func (i SearchItem) AsWorkbook() (*Workbook, bool) {
+	if i.Type != SearchTypeWorkbook {
+		return nil, false
+	}
+	return &Workbook{
+		ID:          i.Content.Luid,
+		Name:        i.Content.Title,
+		Description: i.Content.Description,
+		UpdatedAt:   i.Content.ModifiedTime,
+		Project:     i.project(),
+		Owner:       i.owner(),
+		Tags:        i.tags(),
+	}, true
+}
+
+// AsView returns the hit as a view when it is one.
+func (i SearchItem) AsView() (*View, bool) {
+	if i.Type != SearchTypeView {
+		return nil, false
+	}
+	v := &View{
+		ID:        i.Content.Luid,
+		Name:      i.Content.Title,
+		UpdatedAt: i.Content.ModifiedTime,
+		Project:   i.project(),
+		Owner:     i.owner(),
+		Tags:      i.tags(),
+	}
+	if i.Content.WorkbookID != "" {
+		v.Workbook = &Workbook{ID: i.Content.WorkbookID, Name: i.Content.WorkbookName}
+	}
+	return v, true
+}
+
+// AsDatasource returns the hit as a data source when it is one.
+func (i SearchItem) AsDatasource() (*Datasource, bool) {
+	if i.Type != SearchTypeDatasource {
+		return nil, false
+	}
+	return &Datasource{
+		ID:          i.Content.Luid,
+		Name:        i.Content.Title,
+		Description: i.Content.Description,
+		UpdatedAt:   i.Content.ModifiedTime,
+		Project:     i.project(),
+		Owner:       i.owner(),
+		Tags:        i.tags(),
+	}, true
+}
+
+func (i SearchItem) project() *Project {
+	if i.Content.ProjectID == "" && i.Content.ProjectName == "" {
+		return nil
+	}
+	return &Project{ID: i.Content.ProjectID, Name: i.Content.ProjectName}
+}
+
+func (i SearchItem) owner() *Owner {
+	if i.Content.OwnerID == "" && i.Content.OwnerName == "" {
+		return nil
+	}
+	return &Owner{ID: i.Content.OwnerID, Name: i.Content.OwnerName}
+}
+
+func (i SearchItem) tags() *Tags {
+	if len(i.Content.Tags) == 0 {
+		return nil
+	}
+	var t Tags
+	for _, l := range i.Content.Tags {
+		t.Tag = append(t.Tag, Tag{Label: l})
+	}
+	return &t
+}
@@ -0,0 +1,13 @@
+package model
+
+import "encoding/xml"
+
+// Session describes a signed in user session and the site it is scoped to
+type Session struct {
+	XMLName        xml.Name  `json:"-"                        xml:"session"`
+	SessionID      string    `json:"sessionId,omitempty"      xml:"sessionId,attr,omitempty"`
+	CreatedAt      string    `json:"createdAt,omitempty"      xml:"createdAt,attr,omitempty"`
+	LastAccessedAt string    `json:"lastAccessedAt,omitempty" xml:"lastAccessedAt,attr,omitempty"`
+	Site           *SiteType `json:"site,omitempty"           xml:"site,omitempty"`
+	User           *User     `json:"user,omitempty"           xml:"user,omitempty"`
+}
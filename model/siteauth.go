@@ -0,0 +1,43 @@
+package model
+
+import "encoding/xml"
+
+// SiteAuthConfiguration is one of the authentication methods enabled on a site
+type SiteAuthConfiguration struct {
+	XMLName              xml.Name `json:"-"                               xml:"siteAuthConfiguration"`
+	AuthSetting          string   `json:"authSetting,omitempty"           xml:"authSetting,attr,omitempty"`
+	Enabled              *bool    `json:"enabled,omitempty"               xml:"enabled,attr,omitempty"`
+	IdpConfigurationID   string   `json:"idpConfigurationId,omitempty"    xml:"idpConfigurationId,attr,omitempty"`
+	IdpConfigurationName string   `json:"idpConfigurationName,omitempty"  xml:"idpConfigurationName,attr,omitempty"`
+	KnownProviderAlias   string   `json:"knownProviderAlias,omitempty"    xml:"knownProviderAlias,attr,omitempty"`
+}
+
+type SiteAuthConfigurations struct {
+	XMLName               xml.Name                `json:"-"                                xml:"siteAuthConfigurations"`
+	SiteAuthConfiguration []SiteAuthConfiguration `json:"siteAuthConfiguration,omitempty"  xml:"siteAuthConfiguration,omitempty"`
+}
+
+// SiteOIDCConfiguration is the OpenID Connect identity provider of a site
+type SiteOIDCConfiguration struct {
+	XMLName                     xml.Name `json:"-"                                      xml:"siteOIDCConfiguration"`
+	IdpConfigurationID          string   `json:"idpConfigurationId,omitempty"           xml:"idpConfigurationId,attr,omitempty"`
+	IdpConfigurationName        string   `json:"idpConfigurationName,omitempty"         xml:"idpConfigurationName,attr,omitempty"`
+	Enabled                     *bool    `json:"enabled,omitempty"                      xml:"enabled,attr,omitempty"`
+	KnownProviderAlias          string   `json:"knownProviderAlias,omitempty"           xml:"knownProviderAlias,attr,omitempty"`
+	ClientID                    string   `json:"clientId,omitempty"                     xml:"clientId,attr,omitempty"`
+	ClientSecret                string   `json:"clientSecret,omitempty"                 xml:"clientSecret,attr,omitempty"`
+	ClientAuthentication        string   `json:"clientAuthentication,omitempty"         xml:"clientAuthentication,attr,omitempty"`
+	AuthorizationEndpoint       string   `json:"authorizationEndpoint,omitempty"        xml:"authorizationEndpoint,attr,omitempty"`
+	TokenEndpoint               string   `json:"tokenEndpoint,omitempty"                xml:"tokenEndpoint,attr,omitempty"`
+	UserinfoEndpoint            string   `json:"userinfoEndpoint,omitempty"             xml:"userinfoEndpoint,attr,omitempty"`
+	JwksUri                     string   `json:"jwksUri,omitempty"                      xml:"jwksUri,attr,omitempty"`
+	EndSessionEndpoint          string   `json:"endSessionEndpoint,omitempty"           xml:"endSessionEndpoint,attr,omitempty"`
+	AllowEmbeddedAuthentication *bool    `json:"allowEmbeddedAuthentication,omitempty"  xml:"allowEmbeddedAuthentication,attr,omitempty"`
+	CustomScope                 string   `json:"customScope,omitempty"                  xml:"customScope,attr,omitempty"`
+	Prompt                      string   `json:"prompt,omitempty"                       xml:"prompt,attr,omitempty"`
+	EssentialAcrValues          string   `json:"essentialAcrValues,omitempty"           xml:"essentialAcrValues,attr,omitempty"`
+	VoluntaryAcrValues          string   `json:"voluntaryAcrValues,omitempty"           xml:"voluntaryAcrValues,attr,omitempty"`
+	UseFullName                 *bool    `json:"useFullName,omitempty"                  xml:"useFullName,attr,omitempty"`
+	UserNameClaim               string   `json:"userNameClaim,omitempty"                xml:"userNameClaim,attr,omitempty"`
+	FullNameClaim               string   `json:"fullNameClaim,omitempty"                xml:"fullNameClaim,attr,omitempty"`
+}
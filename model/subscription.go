@@ -0,0 +1,40 @@
+package model
+
+import "encoding/xml"
+
+// Subscription emails a view or workbook to a user on a subscription schedule
+type Subscription struct {
+	XMLName         xml.Name             `json:"-"                          xml:"subscription"`
+	ID              string               `json:"id,omitempty"               xml:"id,attr,omitempty"`
+	Subject         string               `json:"subject,omitempty"          xml:"subject,attr,omitempty"`
+	Message         string               `json:"message,omitempty"          xml:"message,attr,omitempty"`
+	AttachImage     *bool                `json:"attachImage,omitempty"      xml:"attachImage,attr,omitempty"`
+	AttachPdf       *bool                `json:"attachPdf,omitempty"        xml:"attachPdf,attr,omitempty"`
+	PageOrientation string               `json:"pageOrientation,omitempty"  xml:"pageOrientation,attr,omitempty"`
+	PageSizeOption  string               `json:"pageSizeOption,omitempty"   xml:"pageSizeOption,attr,omitempty"`
+	Suspended       *bool                `json:"suspended,omitempty"        xml:"suspended,attr,omitempty"`
+	Content         *SubscriptionContent `json:"content,omitempty"          xml:"content,omitempty"`
+	Schedule        *Schedule            `json:"schedule,omitempty"         xml:"schedule,omitempty"`
+	User            *User                `json:"user,omitempty"             xml:"user,omitempty"`
+}
+
+type Subscriptions struct {
+	XMLName      xml.Name       `json:"-"                       xml:"subscriptions"`
+	Subscription []Subscription `json:"subscription,omitempty"  xml:"subscription,omitempty"`
+}
+
+// SubscriptionContent is the view or workbook a subscription sends
+type SubscriptionContent struct {
+	ID              string `json:"id,omitempty"               xml:"id,attr,omitempty"`
+	Type            string `json:"type,omitempty"             xml:"type,attr,omitempty"`
+	SendIfViewEmpty *bool  `json:"sendIfViewEmpty,omitempty"  xml:"sendIfViewEmpty,attr,omitempty"`
+}
+
+// Subscription content types and page orientations
+const (
+	SubscriptionContentView     = "View"
+	SubscriptionContentWorkbook = "Workbook"
+
+	PageOrientationPortrait  = "Portrait"
+	PageOrientationLandscape = "Landscape"
+)
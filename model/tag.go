@@ -0,0 +1,28 @@
+package model
+
+import "encoding/xml"
+
+type Tags struct {
+	XMLName xml.Name `json:"-"              xml:"tags"`
+	Tag     []Tag    `json:"tag,omitempty"  xml:"tag,omitempty"`
+}
+
+type Tag struct {
+	Label string `json:"label"  xml:"label,attr"`
+}
+
+// Favorite is an entry in a user's favorites list. Exactly one of the content
+// fields is set.
+type Favorite struct {
+	XMLName    xml.Name    `json:"-"                     xml:"favorite"`
+	Label      string      `json:"label,omitempty"       xml:"label,attr,omitempty"`
+	Project    *Project    `json:"project,omitempty"     xml:"project,omitempty"`
+	Workbook   *Workbook   `json:"workbook,omitempty"    xml:"workbook,omitempty"`
+	View       *View       `json:"view,omitempty"        xml:"view,omitempty"`
+	Datasource *Datasource `json:"datasource,omitempty"  xml:"datasource,omitempty"`
+}
+
+type Favorites struct {
+	XMLName  xml.Name   `json:"-"                   xml:"favorites"`
+	Favorite []Favorite `json:"favorite,omitempty"  xml:"favorite,omitempty"`
+}
@@ -1,10 +1,18 @@
 package model
 
+// TrustedTicketRequest asks the server for a trusted ticket. Targetsite is the
+// content URL of the site, empty for the default site. ClientIP is the address
+// of the browser that will redeem the ticket, required when the server checks
+// client IPs.
 type TrustedTicketRequest struct {
 	Username   string `form:"username"`
 	Targetsite string `form:"target_site"`
+	ClientIP   string `form:"client_ip"`
 }
 
+// TrustedTicket is a ticket issued for Site, the content URL of the site it
+// was requested for.
 type TrustedTicket struct {
 	Value string
+	Site  string
 }
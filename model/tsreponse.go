@@ -4,24 +4,87 @@ import "encoding/xml"
 
 // TsResponse is the wrapper that Tableau Server wraps each response with
 type TsResponse struct {
-	XMLName     xml.Name    `json:"-"            xml:"http://tableau.com/api tsResponse"`
-	Pagination  Pagination  `json:"pagination"   xml:"pagination"`
-	ServerInfo  ServerInfo  `json:"serverInfo"   xml:"serverInfo"`
-	Workbooks   Workbooks   `json:"workbooks"    xml:"workbooks"`
-	View        *View       `json:"view"    xml:"view"`
-	Users       Users       `json:"users"        xml:"users"`
-	Credentials Credentials `json:"credentials"  xml:"credentials"`
-	Error       ErrorType   `json:"error"        xml:"error"`
-	Site        SiteType    `json:"site"         xml:"site"`
-	Sites       *Sites      `json:"sites"        xml:"sites"`
+	XMLName                      xml.Name                      `json:"-"            xml:"http://tableau.com/api tsResponse"`
+	Pagination                   Pagination                    `json:"pagination"   xml:"pagination"`
+	ServerInfo                   ServerInfo                    `json:"serverInfo"   xml:"serverInfo"`
+	Workbooks                    Workbooks                     `json:"workbooks"    xml:"workbooks"`
+	View                         *View                         `json:"view"    xml:"view"`
+	Users                        Users                         `json:"users"        xml:"users"`
+	Credentials                  Credentials                   `json:"credentials"  xml:"credentials"`
+	Error                        ErrorType                     `json:"error"        xml:"error"`
+	Site                         SiteType                      `json:"site"         xml:"site"`
+	Sites                        *Sites                        `json:"sites"        xml:"sites"`
+	Project                      *Project                      `json:"project"      xml:"project"`
+	Projects                     *Projects                     `json:"projects"     xml:"projects"`
+	User                         *User                         `json:"user"         xml:"user"`
+	Group                        *Group                        `json:"group"        xml:"group"`
+	Groups                       *Groups                       `json:"groups"       xml:"groups"`
+	Permissions                  *Permissions                  `json:"permissions"  xml:"permissions"`
+	Schedule                     *Schedule                     `json:"schedule"     xml:"schedule"`
+	Schedules                    *Schedules                    `json:"schedules"    xml:"schedules"`
+	Task                         *Task                         `json:"task"         xml:"task"`
+	Tasks                        *Tasks                        `json:"tasks"        xml:"tasks"`
+	Job                          *Job                          `json:"job"          xml:"job"`
+	Subscription                 *Subscription                 `json:"subscription"   xml:"subscription"`
+	Subscriptions                *Subscriptions                `json:"subscriptions"  xml:"subscriptions"`
+	Tags                         *Tags                         `json:"tags"         xml:"tags"`
+	Favorites                    *Favorites                    `json:"favorites"    xml:"favorites"`
+	Workbook                     *Workbook                     `json:"workbook"     xml:"workbook"`
+	Datasource                   *Datasource                   `json:"datasource"   xml:"datasource"`
+	Datasources                  *Datasources                  `json:"datasources"  xml:"datasources"`
+	Revisions                    *Revisions                    `json:"revisions"    xml:"revisions"`
+	Connection                   *Connection                   `json:"connection"   xml:"connection"`
+	Connections                  *Connections                  `json:"connections"  xml:"connections"`
+	Flow                         *Flow                         `json:"flow"         xml:"flow"`
+	Flows                        *Flows                        `json:"flows"        xml:"flows"`
+	FlowRun                      *FlowRun                      `json:"flowRun"      xml:"flowRun"`
+	FlowRuns                     *FlowRuns                     `json:"flowRuns"     xml:"flowRuns"`
+	Webhook                      *Webhook                      `json:"webhook"      xml:"webhook"`
+	Webhooks                     *Webhooks                     `json:"webhooks"     xml:"webhooks"`
+	WebhookTestResult            *WebhookTestResult            `json:"webhookTestResult"  xml:"webhookTestResult"`
+	DataAlert                    *DataAlert                    `json:"dataAlert"    xml:"dataAlert"`
+	DataAlerts                   *DataAlerts                   `json:"dataAlerts"   xml:"dataAlerts"`
+	Session                      *Session                      `json:"session"      xml:"session"`
+	CustomView                   *CustomView                   `json:"customView"   xml:"customView"`
+	CustomViews                  *CustomViews                  `json:"customViews"  xml:"customViews"`
+	DataQualityWarning           *DataQualityWarning           `json:"dataQualityWarning"      xml:"dataQualityWarning"`
+	DataQualityWarningList       *DataQualityWarningList       `json:"dataQualityWarningList"  xml:"dataQualityWarningList"`
+	Label                        *Label                        `json:"label"        xml:"label"`
+	LabelList                    *LabelList                    `json:"labelList"    xml:"labelList"`
+	Database                     *Database                     `json:"database"     xml:"database"`
+	Databases                    *Databases                    `json:"databases"    xml:"databases"`
+	Table                        *Table                        `json:"table"        xml:"table"`
+	Tables                       *Tables                       `json:"tables"       xml:"tables"`
+	Column                       *Column                       `json:"column"       xml:"column"`
+	Columns                      *Columns                      `json:"columns"      xml:"columns"`
+	VirtualConnection            *VirtualConnection            `json:"virtualConnection"  xml:"virtualConnection"`
+	VirtualConnections           *VirtualConnections           `json:"virtualConnections"  xml:"virtualConnections"`
+	VirtualConnectionConnections *VirtualConnectionConnections `json:"virtualConnectionConnections"  xml:"virtualConnectionConnections"`
+	IdentityPool                 *IdentityPool                 `json:"identityPool"  xml:"identityPool"`
+	IdentityPools                *IdentityPools                `json:"identityPools"  xml:"identityPools"`
+	IdentityStore                *IdentityStore                `json:"identityStore"  xml:"identityStore"`
+	IdentityStores               *IdentityStores               `json:"identityStores"  xml:"identityStores"`
+	Metric                       *Metric                       `json:"metric"  xml:"metric"`
+	Metrics                      *Metrics                      `json:"metrics"  xml:"metrics"`
+	Collection                   *Collection                   `json:"collection"  xml:"collection"`
+	Collections                  *Collections                  `json:"collections"  xml:"collections"`
+	CollectionItems              *CollectionItems              `json:"collectionItems"  xml:"collectionItems"`
+	SiteAuthConfigurations       *SiteAuthConfigurations       `json:"siteAuthConfigurations"  xml:"siteAuthConfigurations"`
+	SiteOIDCConfiguration        *SiteOIDCConfiguration        `json:"siteOIDCConfiguration"  xml:"siteOIDCConfiguration"`
 }
 
 //Pagination defines the nuber of pages returned by the api
 type Pagination struct {
-	XMLName    xml.Name `json:"-"                         xml:"pagination"`
-	PageNumber int      `json:"pageNumber,omitempty"      xml:"pageNumber"`
-	PageSize   int      `json:"page-size,omitempty"       xml:"page-size"`
-	TotalPages int      `json:"total-available,omitempty" xml:"total-available"`
+	XMLName        xml.Name `json:"-"                         xml:"pagination"`
+	PageNumber     int      `json:"pageNumber,string,omitempty"     xml:"pageNumber,attr,omitempty"`
+	PageSize       int      `json:"pageSize,string,omitempty"       xml:"pageSize,attr,omitempty"`
+	TotalAvailable int      `json:"totalAvailable,string,omitempty" xml:"totalAvailable,attr,omitempty"`
+}
+
+// HasMore reports whether there are pages after the one described by p. A
+// response without a page size is treated as the last page.
+func (p Pagination) HasMore() bool {
+	return p.PageSize > 0 && p.PageNumber*p.PageSize < p.TotalAvailable
 }
 
 // ServerInfo contains information about product version and api version for the server
@@ -38,24 +101,50 @@ type Sites struct {
 
 // SiteType is site detail and can be a list under Sites or info under other response details
 type SiteType struct {
-	XMLName      xml.Name `json:"-"                       xml:"site"`
-	ID           string   `json:"id,omitempty"            xml:"id,attr,omitempty"`
-	Name         string   `json:"name,omitempty"          xml:"name,attr,omitempty"`
-	ContentUrl   string   `json:"contentUrl,omitempty"    xml:"contentUrl,attr,omitempty"`
-	AdminMode    string   `json:"adminMode,omitempty"     xml:"adminMode,attr,omitempty"`
-	UserQuota    string   `json:"userQuota,omitempty"     xml:"userQuota,attr,omitempty"`
-	StorageQuota int      `json:"storageQuota,omitempty"  xml:"storageQuota,attr,omitempty"`
-	State        string   `json:"state,omitempty"         xml:"state,attr,omitempty"`
-	StatusReason string   `json:"statusReason,omitempty"  xml:"statusReason,attr,omitempty"`
-	Usage        struct {
-		NumUsers     uint `json:"numUsers"                xml:"numUsers,attr"`
-		NumCreators  uint `json:"numCreators,omitempty"   xml:"numCreators,omitempty,attr"`
-		NumExplorers uint `json:"numExplorers,omitempty"  xml:"numExplorers,omitempty,attr"`
-		NumViewers   uint `json:"numViewers,omitempty"    xml:"numViewers,omitempty,attr"`
-		Storage      uint `json:"storage"                 xml:"storage,attr"`
-	} `json:"usage,omitempty"  xml:"usage,omitempty"`
+	XMLName                xml.Name   `json:"-"                                   xml:"site"`
+	ID                     string     `json:"id,omitempty"                        xml:"id,attr,omitempty"`
+	Name                   string     `json:"name,omitempty"                      xml:"name,attr,omitempty"`
+	ContentUrl             string     `json:"contentUrl,omitempty"                xml:"contentUrl,attr,omitempty"`
+	AdminMode              string     `json:"adminMode,omitempty"                 xml:"adminMode,attr,omitempty"`
+	UserQuota              string     `json:"userQuota,omitempty"                 xml:"userQuota,attr,omitempty"`
+	StorageQuota           int        `json:"storageQuota,omitempty"              xml:"storageQuota,attr,omitempty"`
+	State                  string     `json:"state,omitempty"                     xml:"state,attr,omitempty"`
+	StatusReason           string     `json:"statusReason,omitempty"              xml:"statusReason,attr,omitempty"`
+	DisableSubscriptions   *bool      `json:"disableSubscriptions,omitempty"      xml:"disableSubscriptions,attr,omitempty"`
+	SubscribeOthersEnabled *bool      `json:"subscribeOthersEnabled,omitempty"    xml:"subscribeOthersEnabled,attr,omitempty"`
+	RevisionHistoryEnabled *bool      `json:"revisionHistoryEnabled,omitempty"    xml:"revisionHistoryEnabled,attr,omitempty"`
+	RevisionLimit          string     `json:"revisionLimit,omitempty"             xml:"revisionLimit,attr,omitempty"`
+	GuestAccessEnabled     *bool      `json:"guestAccessEnabled,omitempty"        xml:"guestAccessEnabled,attr,omitempty"`
+	CacheWarmupEnabled     *bool      `json:"cacheWarmupEnabled,omitempty"        xml:"cacheWarmupEnabled,attr,omitempty"`
+	CommentingEnabled      *bool      `json:"commentingEnabled,omitempty"         xml:"commentingEnabled,attr,omitempty"`
+	FlowsEnabled           *bool      `json:"flowsEnabled,omitempty"              xml:"flowsEnabled,attr,omitempty"`
+	ExtractEncryptionMode  string     `json:"extractEncryptionMode,omitempty"     xml:"extractEncryptionMode,attr,omitempty"`
+	TierCreatorCapacity    string     `json:"tierCreatorCapacity,omitempty"       xml:"tierCreatorCapacity,attr,omitempty"`
+	TierExplorerCapacity   string     `json:"tierExplorerCapacity,omitempty"      xml:"tierExplorerCapacity,attr,omitempty"`
+	TierViewerCapacity     string     `json:"tierViewerCapacity,omitempty"        xml:"tierViewerCapacity,attr,omitempty"`
+	Usage                  *SiteUsage `json:"usage,omitempty"                     xml:"usage,omitempty"`
+	UnrestrictedEmbedding  *bool      `json:"unrestrictedEmbedding,omitempty"  xml:"unrestrictedEmbedding,attr,omitempty"`
+	AllowList              string     `json:"allowList,omitempty"  xml:"allowList,attr,omitempty"`
+}
+
+// SiteUsage is returned when a site is queried with its usage statistics
+type SiteUsage struct {
+	NumUsers     uint `json:"numUsers"                xml:"numUsers,attr"`
+	NumCreators  uint `json:"numCreators,omitempty"   xml:"numCreators,omitempty,attr"`
+	NumExplorers uint `json:"numExplorers,omitempty"  xml:"numExplorers,omitempty,attr"`
+	NumViewers   uint `json:"numViewers,omitempty"    xml:"numViewers,omitempty,attr"`
+	Storage      uint `json:"storage"                 xml:"storage,attr"`
 }
 
+// Site states and administration modes
+const (
+	SiteStateActive    = "Active"
+	SiteStateSuspended = "Suspended"
+
+	AdminModeContentAndUsers = "ContentAndUsers"
+	AdminModeContentOnly     = "ContentOnly"
+)
+
 type Credentials struct {
 	XMLName     xml.Name  `json:"-"                   xml:"credentials"`
 	Name        string    `json:"name,omitempty"      xml:"name,attr,omitempty"`
@@ -72,52 +161,87 @@ type ProductVersion struct {
 }
 
 type User struct {
-	XMLName  xml.Name `json:"-"                   xml:"user"`
-	ID       string   `json:"id,omitempty"        xml:"id,attr,omitempty"`
-	Name     string   `json:"name,omitempty"      xml:"name,attr,omitempty"`
-	SiteRole string   `json:"siteRole,omitempty"  xml:"siteRole,attr,omitempty"`
-	FullName string   `json:"fullName,omitempty"  xml:"fullName,attr,omitempty"`
+	XMLName            xml.Name `json:"-"                             xml:"user"`
+	ID                 string   `json:"id,omitempty"                  xml:"id,attr,omitempty"`
+	Name               string   `json:"name,omitempty"                xml:"name,attr,omitempty"`
+	SiteRole           string   `json:"siteRole,omitempty"            xml:"siteRole,attr,omitempty"`
+	FullName           string   `json:"fullName,omitempty"            xml:"fullName,attr,omitempty"`
+	Email              string   `json:"email,omitempty"               xml:"email,attr,omitempty"`
+	Password           string   `json:"password,omitempty"            xml:"password,attr,omitempty"`
+	LastLogin          string   `json:"lastLogin,omitempty"           xml:"lastLogin,attr,omitempty"`
+	AuthSetting        string   `json:"authSetting,omitempty"         xml:"authSetting,attr,omitempty"`
+	ExternalAuthUserID string   `json:"externalAuthUserId,omitempty"  xml:"externalAuthUserId,attr,omitempty"`
 }
 
+// Site roles a user can be assigned
+const (
+	SiteRoleCreator                   = "Creator"
+	SiteRoleExplorer                  = "Explorer"
+	SiteRoleExplorerCanPublish        = "ExplorerCanPublish"
+	SiteRoleViewer                    = "Viewer"
+	SiteRoleUnlicensed                = "Unlicensed"
+	SiteRoleServerAdministrator       = "ServerAdministrator"
+	SiteRoleSiteAdministratorCreator  = "SiteAdministratorCreator"
+	SiteRoleSiteAdministratorExplorer = "SiteAdministratorExplorer"
+)
+
+// Authentication types a user can sign in with
+const (
+	AuthSettingServerDefault = "ServerDefault"
+	AuthSettingSAML          = "SAML"
+	AuthSettingOpenID        = "OpenID"
+)
+
 type Users struct {
 	XMLName xml.Name `json:"-"                   xml:"users"`
 	User    []User   `json:"user"                xml:"user"`
 }
 
 type Workbook struct {
-	XMLName       xml.Name `json:"-"                      xml:"workbook"`
-	ID            string   `json:"id,omitempty"           xml:"id,attr,omitempty"`
-	Name          string   `json:"name,omitempty"         xml:"name,attr,omitempty"`
-	Description   string   `json:"description,omitempty"  xml:"description,attr,omitempty"`
-	WebPageUrl    string   `json:"webpageurl,omitempty"   xml:"webpageurl,attr,omitempty"`
-	ContentUrl    string   `json:"contentUrl,omitempty"   xml:"contentUrl,attr,omitempty"`
-	ShowTabs      string   `json:"showTabs,omitempty"      xml:"showTabs,attr,omitempty"`
-	Size          string   `json:"size,omitempty"          xml:"size,attr,omitempty"`
-	CreatedAt     string   `json:"createdAt,omitempty"     xml:"createdAt,attr,omitempty"`
-	UpdatedAt     string   `json:"updatedAt,omitempty"     xml:"updatedAt,attr,omitempty"`
-	DefaultViewId string   `json:"defaultViewId,omitempty" xml:"defaultViewId,attr,omitempty"`
+	XMLName                xml.Name                `json:"-"                      xml:"workbook"`
+	ID                     string                  `json:"id,omitempty"           xml:"id,attr,omitempty"`
+	Name                   string                  `json:"name,omitempty"         xml:"name,attr,omitempty"`
+	Description            string                  `json:"description,omitempty"  xml:"description,attr,omitempty"`
+	WebPageUrl             string                  `json:"webpageurl,omitempty"   xml:"webpageurl,attr,omitempty"`
+	ContentUrl             string                  `json:"contentUrl,omitempty"   xml:"contentUrl,attr,omitempty"`
+	ShowTabs               string                  `json:"showTabs,omitempty"      xml:"showTabs,attr,omitempty"`
+	Size                   string                  `json:"size,omitempty"          xml:"size,attr,omitempty"`
+	CreatedAt              string                  `json:"createdAt,omitempty"     xml:"createdAt,attr,omitempty"`
+	UpdatedAt              string                  `json:"updatedAt,omitempty"     xml:"updatedAt,attr,omitempty"`
+	DefaultViewId          string                  `json:"defaultViewId,omitempty" xml:"defaultViewId,attr,omitempty"`
+	Tags                   *Tags                   `json:"tags,omitempty"          xml:"tags,omitempty"`
+	Project                *Project                `json:"project,omitempty"       xml:"project,omitempty"`
+	Owner                  *Owner                  `json:"owner,omitempty"         xml:"owner,omitempty"`
+	DataAccelerationConfig *DataAccelerationConfig `json:"dataAccelerationConfig,omitempty"  xml:"dataAccelerationConfig,omitempty"`
 }
 
 type Workbooks struct {
 	XMLName  xml.Name   `json:"-"                       xml:"workbooks"`
 	Workbook []Workbook `json:"workbook,omitempty"      xml:"workbook,omitempty"`
-	Project  *Project   `json:"project,omitempty" xml:"project,attr,omitempty"`
-	Owner    *Owner     `json:"owner,omitempty" xml:"owner,attr,omitempty"`
+	// Deprecated: the workbooks element carries no project. Use the Project
+	// of each Workbook.
+	Project *Project `json:"project,omitempty"       xml:"-"`
+	// Deprecated: the workbooks element carries no owner. Use the Owner of
+	// each Workbook.
+	Owner *Owner `json:"owner,omitempty"         xml:"-"`
 }
 
 type View struct {
-	XMLName    xml.Name `json:"-"                      xml:"view"`
-	ID         string   `json:"id,omitempty"           xml:"id,attr,omitempty"`
-	Name       string   `json:"name,omitempty"         xml:"name,attr,omitempty"`
-	ContentUrl string   `json:"contentUrl,omitempty"   xml:"contentUrl,attr,omitempty"`
-	CreatedAt  string   `json:"createdAt,omitempty"     xml:"createdAt,attr,omitempty"`
-	UpdatedAt  string   `json:"updatedAt,omitempty"     xml:"updatedAt,attr,omitempty"`
-	Usage      struct {
-		TotalViewCount uint `json:"totalViewCount"                xml:"totalViewCount,attr"`
-	}
-	Workbook *Workbook `json:"workbook,omitempty"               xml:"workbook,attr,omitempty"`
-	Owner    *Owner    `json:"owner,omitempty"               xml:"owner,attr,omitempty"`
-	Project  *Project  `json:"project,omitempty"               xml:project,attr,omitempty"`
+	XMLName    xml.Name   `json:"-"                      xml:"view"`
+	ID         string     `json:"id,omitempty"           xml:"id,attr,omitempty"`
+	Name       string     `json:"name,omitempty"         xml:"name,attr,omitempty"`
+	ContentUrl string     `json:"contentUrl,omitempty"   xml:"contentUrl,attr,omitempty"`
+	CreatedAt  string     `json:"createdAt,omitempty"     xml:"createdAt,attr,omitempty"`
+	UpdatedAt  string     `json:"updatedAt,omitempty"     xml:"updatedAt,attr,omitempty"`
+	Usage      *ViewUsage `json:"usage,omitempty"      xml:"usage,omitempty"`
+	Workbook   *Workbook  `json:"workbook,omitempty"               xml:"workbook,omitempty"`
+	Owner      *Owner     `json:"owner,omitempty"               xml:"owner,omitempty"`
+	Project    *Project   `json:"project,omitempty"               xml:"project,omitempty"`
+	Tags       *Tags      `json:"tags,omitempty"                xml:"tags,omitempty"`
+}
+
+type ViewUsage struct {
+	TotalViewCount uint `json:"totalViewCount"                xml:"totalViewCount,attr"`
 }
 
 type Views struct {
@@ -126,12 +250,34 @@ type Views struct {
 }
 
 type Project struct {
-	ID   string `json:"id,omitempty"        xml:"id,attr,omitempty"`
-	Name string `json:"name,omitempty"      xml:"name,attr,omitempty"`
+	ID                 string             `json:"id,omitempty"                  xml:"id,attr,omitempty"`
+	Name               string             `json:"name,omitempty"                xml:"name,attr,omitempty"`
+	Description        string             `json:"description,omitempty"         xml:"description,attr,omitempty"`
+	ParentProjectID    string             `json:"parentProjectId,omitempty"     xml:"parentProjectId,attr,omitempty"`
+	ContentPermissions ContentPermissions `json:"contentPermissions,omitempty"  xml:"contentPermissions,attr,omitempty"`
+	CreatedAt          string             `json:"createdAt,omitempty"           xml:"createdAt,attr,omitempty"`
+	UpdatedAt          string             `json:"updatedAt,omitempty"           xml:"updatedAt,attr,omitempty"`
+	Owner              *Owner             `json:"owner,omitempty"               xml:"owner,omitempty"`
 }
 
+type Projects struct {
+	XMLName xml.Name  `json:"-"                   xml:"projects"`
+	Project []Project `json:"project,omitempty"   xml:"project,omitempty"`
+}
+
+// ContentPermissions controls whether project permissions are enforced on the
+// content inside it or left to the content owners.
+type ContentPermissions string
+
+const (
+	LockedToProject              ContentPermissions = "LockedToProject"
+	LockedToProjectWithoutNested ContentPermissions = "LockedToProjectWithoutNested"
+	ManagedByOwner               ContentPermissions = "ManagedByOwner"
+)
+
 type Owner struct {
-	ID string `json:"id,omitempty"          xml:"id,attr,omitempty"`
+	ID   string `json:"id,omitempty"          xml:"id,attr,omitempty"`
+	Name string `json:"name,omitempty"        xml:"name,attr,omitempty"`
 }
 
 type DefaultViewId struct {
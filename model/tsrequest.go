@@ -4,9 +4,48 @@ import "encoding/xml"
 
 // TsRequest is the wrapper that Tableau Server expects requests to be wrapped with
 type TsRequest struct {
-	XMLName     xml.Name    `json:"-"                      xml:"http://tableau.com/api tsRequest"`
-	Credentials Credentials `json:"credentials,omitempty"  xml:"credentials,omitempty"`
-	Site        SiteType    `json:"site,omitempty"         xml:"site,omitempty"`
+	XMLName               xml.Name               `json:"-"                      xml:"http://tableau.com/api tsRequest"`
+	Credentials           *Credentials           `json:"credentials,omitempty"  xml:"credentials,omitempty"`
+	Site                  *SiteType              `json:"site,omitempty"         xml:"site,omitempty"`
+	Project               *Project               `json:"project,omitempty"      xml:"project,omitempty"`
+	User                  *User                  `json:"user,omitempty"         xml:"user,omitempty"`
+	Group                 *Group                 `json:"group,omitempty"        xml:"group,omitempty"`
+	Permissions           *Permissions           `json:"permissions,omitempty"  xml:"permissions,omitempty"`
+	Schedule              *Schedule              `json:"schedule,omitempty"     xml:"schedule,omitempty"`
+	Task                  *Task                  `json:"task,omitempty"         xml:"task,omitempty"`
+	Subscription          *Subscription          `json:"subscription,omitempty"  xml:"subscription,omitempty"`
+	Tags                  *Tags                  `json:"tags,omitempty"         xml:"tags,omitempty"`
+	Favorite              *Favorite              `json:"favorite,omitempty"     xml:"favorite,omitempty"`
+	Workbook              *Workbook              `json:"workbook,omitempty"     xml:"workbook,omitempty"`
+	Datasource            *Datasource            `json:"datasource,omitempty"   xml:"datasource,omitempty"`
+	Connection            *Connection            `json:"connection,omitempty"   xml:"connection,omitempty"`
+	Flow                  *Flow                  `json:"flow,omitempty"         xml:"flow,omitempty"`
+	Webhook               *Webhook               `json:"webhook,omitempty"      xml:"webhook,omitempty"`
+	DataAlert             *DataAlert             `json:"dataAlert,omitempty"    xml:"dataAlert,omitempty"`
+	CustomView            *CustomView            `json:"customView,omitempty"   xml:"customView,omitempty"`
+	DataQualityWarning    *DataQualityWarning    `json:"dataQualityWarning,omitempty"  xml:"dataQualityWarning,omitempty"`
+	Label                 *Label                 `json:"label,omitempty"        xml:"label,omitempty"`
+	ContentList           *ContentList           `json:"contentList,omitempty"  xml:"contentList,omitempty"`
+	Database              *Database              `json:"database,omitempty"     xml:"database,omitempty"`
+	Table                 *Table                 `json:"table,omitempty"        xml:"table,omitempty"`
+	Column                *Column                `json:"column,omitempty"       xml:"column,omitempty"`
+	VirtualConnection     *VirtualConnection     `json:"virtualConnection,omitempty"  xml:"virtualConnection,omitempty"`
+	IdentityPool          *IdentityPool          `json:"identityPool,omitempty"  xml:"identityPool,omitempty"`
+	IdentityStore         *IdentityStore         `json:"identityStore,omitempty"  xml:"identityStore,omitempty"`
+	Metric                *Metric                `json:"metric,omitempty"  xml:"metric,omitempty"`
+	SiteOIDCConfiguration *SiteOIDCConfiguration `json:"siteOIDCConfiguration,omitempty"  xml:"siteOIDCConfiguration,omitempty"`
 }
 
 //
+
+// SwitchSiteRequest is the body of a switch site call. Unlike TsRequest.Site
+// it always carries contentUrl, which is empty for the default site.
+type SwitchSiteRequest struct {
+	XMLName xml.Name `json:"-"     xml:"http://tableau.com/api tsRequest"`
+	Site    SiteRef  `json:"site"  xml:"site"`
+}
+
+// SiteRef names a site by its content URL
+type SiteRef struct {
+	ContentUrl string `json:"contentUrl"  xml:"contentUrl,attr"`
+}
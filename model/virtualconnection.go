@@ -0,0 +1,32 @@
+package model
+
+import "encoding/xml"
+
+// VirtualConnection is a shared set of database connections with its own
+// data policies
+type VirtualConnection struct {
+	XMLName     xml.Name `json:"-"                      xml:"virtualConnection"`
+	ID          string   `json:"id,omitempty"           xml:"id,attr,omitempty"`
+	Name        string   `json:"name,omitempty"         xml:"name,attr,omitempty"`
+	Description string   `json:"description,omitempty"  xml:"description,attr,omitempty"`
+	WebPageUrl  string   `json:"webpageUrl,omitempty"   xml:"webpageUrl,attr,omitempty"`
+	CreatedAt   string   `json:"createdAt,omitempty"    xml:"createdAt,attr,omitempty"`
+	UpdatedAt   string   `json:"updatedAt,omitempty"    xml:"updatedAt,attr,omitempty"`
+	IsCertified *bool    `json:"isCertified,omitempty"  xml:"isCertified,attr,omitempty"`
+	HasExtracts *bool    `json:"hasExtracts,omitempty"  xml:"hasExtracts,attr,omitempty"`
+	Content     string   `json:"content,omitempty"      xml:"content,attr,omitempty"`
+	Project     *Project `json:"project,omitempty"      xml:"project,omitempty"`
+	Owner       *Owner   `json:"owner,omitempty"        xml:"owner,omitempty"`
+}
+
+type VirtualConnections struct {
+	XMLName           xml.Name            `json:"-"                            xml:"virtualConnections"`
+	VirtualConnection []VirtualConnection `json:"virtualConnection,omitempty"  xml:"virtualConnection,omitempty"`
+}
+
+// VirtualConnectionConnections lists the database connections of a virtual
+// connection
+type VirtualConnectionConnections struct {
+	XMLName    xml.Name     `json:"-"                     xml:"virtualConnectionConnections"`
+	Connection []Connection `json:"connection,omitempty"  xml:"connection,omitempty"`
+}
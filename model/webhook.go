@@ -0,0 +1,145 @@
+package model
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"strings"
+	"unicode"
+)
+
+type Webhook struct {
+	XMLName            xml.Name            `json:"-"                             xml:"webhook"`
+	ID                 string              `json:"id,omitempty"                  xml:"id,attr,omitempty"`
+	Name               string              `json:"name,omitempty"                xml:"name,attr,omitempty"`
+	Event              WebhookEvent        `json:"event,omitempty"               xml:"event,attr,omitempty"`
+	IsEnabled          *bool               `json:"isEnabled,omitempty"           xml:"isEnabled,attr,omitempty"`
+	StatusChangeReason string              `json:"statusChangeReason,omitempty"  xml:"statusChangeReason,attr,omitempty"`
+	CreatedAt          string              `json:"createdAt,omitempty"           xml:"createdAt,attr,omitempty"`
+	UpdatedAt          string              `json:"updatedAt,omitempty"           xml:"updatedAt,attr,omitempty"`
+	Source             *WebhookSource      `json:"webhook-source,omitempty"      xml:"webhook-source,omitempty"`
+	Destination        *WebhookDestination `json:"webhook-destination,omitempty" xml:"webhook-destination,omitempty"`
+	Owner              *Owner              `json:"owner,omitempty"               xml:"owner,omitempty"`
+}
+
+type Webhooks struct {
+	XMLName xml.Name  `json:"-"                  xml:"webhooks"`
+	Webhook []Webhook `json:"webhook,omitempty"  xml:"webhook,omitempty"`
+}
+
+// WebhookSource holds the event that triggers a webhook. The API encodes the
+// event as the name of a single empty child element, for example
+// <webhook-source-event-workbook-refresh-failed/>.
+type WebhookSource struct {
+	Element struct {
+		XMLName xml.Name
+	} `xml:",any"`
+}
+
+// Event returns the event named by the source element.
+func (s WebhookSource) Event() (WebhookEvent, error) {
+	return ParseWebhookEvent(s.Element.XMLName.Local)
+}
+
+func (s WebhookSource) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]struct{}{s.Element.XMLName.Local: {}})
+}
+
+func (s *WebhookSource) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+	for k := range m {
+		s.Element.XMLName.Local = k
+		return nil
+	}
+	return errors.New("webhook-source has no event")
+}
+
+type WebhookDestination struct {
+	HTTP *WebhookDestinationHttp `json:"webhook-destination-http,omitempty"  xml:"webhook-destination-http,omitempty"`
+}
+
+// WebhookDestinationHttp is the endpoint a webhook posts its payload to. Only
+// POST is supported by the server.
+type WebhookDestinationHttp struct {
+	Method string `json:"method,omitempty"  xml:"method,attr,omitempty"`
+	Url    string `json:"url,omitempty"     xml:"url,attr,omitempty"`
+}
+
+// WebhookTestResult is the response of the server to a test webhook call
+type WebhookTestResult struct {
+	XMLName xml.Name `json:"-"                xml:"webhookTestResult"`
+	ID      string   `json:"id,omitempty"     xml:"id,attr,omitempty"`
+	Status  int      `json:"status,omitempty" xml:"status,attr,omitempty"`
+	Body    string   `json:"body,omitempty"   xml:"body,omitempty"`
+}
+
+// WebhookEvent is the friendly name of an event a webhook can be triggered by
+type WebhookEvent string
+
+const (
+	DatasourceCreated          WebhookEvent = "DatasourceCreated"
+	DatasourceUpdated          WebhookEvent = "DatasourceUpdated"
+	DatasourceDeleted          WebhookEvent = "DatasourceDeleted"
+	DatasourceRefreshStarted   WebhookEvent = "DatasourceRefreshStarted"
+	DatasourceRefreshSucceeded WebhookEvent = "DatasourceRefreshSucceeded"
+	DatasourceRefreshFailed    WebhookEvent = "DatasourceRefreshFailed"
+	WorkbookCreated            WebhookEvent = "WorkbookCreated"
+	WorkbookUpdated            WebhookEvent = "WorkbookUpdated"
+	WorkbookDeleted            WebhookEvent = "WorkbookDeleted"
+	WorkbookRefreshStarted     WebhookEvent = "WorkbookRefreshStarted"
+	WorkbookRefreshSucceeded   WebhookEvent = "WorkbookRefreshSucceeded"
+	WorkbookRefreshFailed      WebhookEvent = "WorkbookRefreshFailed"
+	ViewDeleted                WebhookEvent = "ViewDeleted"
+	AdminPromoted              WebhookEvent = "AdminPromoted"
+	AdminDemoted               WebhookEvent = "AdminDemoted"
+	UserDeleted                WebhookEvent = "UserDeleted"
+	LabelCreated               WebhookEvent = "LabelCreated"
+	LabelUpdated               WebhookEvent = "LabelUpdated"
+	LabelDeleted               WebhookEvent = "LabelDeleted"
+)
+
+const webhookSourcePrefix = "webhook-source-event-"
+
+// Source returns the webhook-source element for the event, such as
+// webhook-source-event-workbook-refresh-failed for WorkbookRefreshFailed.
+func (e WebhookEvent) Source() *WebhookSource {
+	var b strings.Builder
+	b.WriteString(webhookSourcePrefix)
+	for i, r := range string(e) {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('-')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	var s WebhookSource
+	s.Element.XMLName.Local = b.String()
+	return &s
+}
+
+// ParseWebhookEvent converts an event name in either its friendly form
+// (WorkbookRefreshFailed) or its API form
+// (webhook-source-event-workbook-refresh-failed) to a WebhookEvent.
+func ParseWebhookEvent(name string) (WebhookEvent, error) {
+	if name == "" {
+		return "", errors.New("empty webhook event name")
+	}
+	if !strings.Contains(name, "-") {
+		return WebhookEvent(name), nil
+	}
+	name = strings.TrimPrefix(name, webhookSourcePrefix)
+	name = strings.TrimPrefix(name, "webhook-source-api-")
+	var b strings.Builder
+	for _, part := range strings.Split(name, "-") {
+		if part == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return WebhookEvent(b.String()), nil
+}
@@ -0,0 +1,76 @@
+package model
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestWebhookEventSource(t *testing.T) {
+	tests := []struct {
+		event WebhookEvent
+		name  string
+	}{
+		{WorkbookRefreshFailed, "webhook-source-event-workbook-refresh-failed"},
+		{DatasourceCreated, "webhook-source-event-datasource-created"},
+		{AdminPromoted, "webhook-source-event-admin-promoted"},
+		{ViewDeleted, "webhook-source-event-view-deleted"},
+	}
+	for _, tt := range tests {
+		if got := tt.event.Source().Element.XMLName.Local; got != tt.name {
+			t.Errorf("%s.Source() = %q, want %q", tt.event, got, tt.name)
+		}
+		for _, name := range []string{tt.name, string(tt.event)} {
+			e, err := ParseWebhookEvent(name)
+			if err != nil || e != tt.event {
+				t.Errorf("ParseWebhookEvent(%q) = %q, %v, want %q", name, e, err, tt.event)
+			}
+		}
+	}
+	if e, err := ParseWebhookEvent("webhook-source-api-workbook-deleted"); err != nil || e != WorkbookDeleted {
+		t.Errorf("ParseWebhookEvent(api form) = %q, %v", e, err)
+	}
+	if _, err := ParseWebhookEvent(""); err == nil {
+		t.Error("ParseWebhookEvent(\"\") should fail")
+	}
+}
+
+func TestWebhookSourceXml(t *testing.T) {
+	in := `<webhook id="w1" name="refresh"><webhook-source><webhook-source-event-datasource-refresh-failed/></webhook-source><webhook-destination><webhook-destination-http method="POST" url="https://example.com/hook"/></webhook-destination></webhook>`
+	var w Webhook
+	if err := xml.Unmarshal([]byte(in), &w); err != nil {
+		t.Fatal(err)
+	}
+	e, err := w.Source.Event()
+	if err != nil || e != DatasourceRefreshFailed {
+		t.Errorf("Event() = %q, %v", e, err)
+	}
+	if w.Destination == nil || w.Destination.HTTP.Url != "https://example.com/hook" {
+		t.Errorf("destination = %+v", w.Destination)
+	}
+	out, err := xml.Marshal(Webhook{Name: "refresh", Source: DatasourceRefreshFailed.Source()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(out), "<webhook-source><webhook-source-event-datasource-refresh-failed></webhook-source-event-datasource-refresh-failed></webhook-source>") {
+		t.Errorf("marshalled %s", out)
+	}
+}
+
+func TestWebhookSourceJson(t *testing.T) {
+	b, err := json.Marshal(WorkbookCreated.Source())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"webhook-source-event-workbook-created":{}}` {
+		t.Errorf("marshalled %s", b)
+	}
+	var s WebhookSource
+	if err = json.Unmarshal(b, &s); err != nil {
+		t.Fatal(err)
+	}
+	if e, _ := s.Event(); e != WorkbookCreated {
+		t.Errorf("Event() = %q", e)
+	}
+}
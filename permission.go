@@ -0,0 +1,94 @@
+package gotabgo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+// QueryPermissions returns the explicit permissions set on a project,
+// workbook, view, data source or flow.
+func (t *TabApi) QueryPermissions(resource model.Resource, id string) (p *model.Permissions, err error) {
+	url := fmt.Sprintf("%s/%s/%s/permissions", t.getSiteUrl(), resource, id)
+	return t.getPermissions("QueryPermissions", url)
+}
+
+// AddPermissions grants capabilities to users and groups on a piece of
+// content. Capabilities already set are left in place.
+func (t *TabApi) AddPermissions(resource model.Resource, id string, grants []model.GranteeCapabilities) (p *model.Permissions, err error) {
+	url := fmt.Sprintf("%s/%s/%s/permissions", t.getSiteUrl(), resource, id)
+	return t.putPermissions("AddPermissions", url, grants)
+}
+
+// DeletePermission removes one capability from the user or group named in
+// grantee.
+func (t *TabApi) DeletePermission(resource model.Resource, id string, grantee model.GranteeCapabilities, capability model.Capability) (err error) {
+	path, err := granteePath(grantee)
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%s/%s/%s/permissions/%s/%s/%s", t.getSiteUrl(), resource, id, path, capability.Name, capability.Mode)
+	_, err = t.send("DeletePermission", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
+
+// QueryDefaultPermissions returns the permissions a project applies to new
+// content of the given type.
+func (t *TabApi) QueryDefaultPermissions(projectID string, resource model.Resource) (p *model.Permissions, err error) {
+	url := fmt.Sprintf("%s/projects/%s/default-permissions/%s", t.getSiteUrl(), projectID, resource)
+	return t.getPermissions("QueryDefaultPermissions", url)
+}
+
+// SetDefaultPermissions adds capabilities to the default permissions a project
+// applies to new content of the given type.
+func (t *TabApi) SetDefaultPermissions(projectID string, resource model.Resource, grants []model.GranteeCapabilities) (p *model.Permissions, err error) {
+	url := fmt.Sprintf("%s/projects/%s/default-permissions/%s", t.getSiteUrl(), projectID, resource)
+	return t.putPermissions("SetDefaultPermissions", url, grants)
+}
+
+// DeleteDefaultPermission removes one capability from a project's default
+// permissions for the given content type.
+func (t *TabApi) DeleteDefaultPermission(projectID string, resource model.Resource, grantee model.GranteeCapabilities, capability model.Capability) (err error) {
+	path, err := granteePath(grantee)
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%s/projects/%s/default-permissions/%s/%s/%s/%s", t.getSiteUrl(), projectID, resource, path, capability.Name, capability.Mode)
+	_, err = t.send("DeleteDefaultPermission", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
+
+func (t *TabApi) getPermissions(caller, url string) (p *model.Permissions, err error) {
+	tResponse, err := t.send(caller, http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Permissions, nil
+}
+
+func (t *TabApi) putPermissions(caller, url string, grants []model.GranteeCapabilities) (p *model.Permissions, err error) {
+	tsRequest := model.TsRequest{
+		Permissions: &model.Permissions{GranteeCapabilities: grants},
+	}
+	tResponse, err := t.send(caller, http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Permissions, nil
+}
+
+// granteePath returns the "users/<id>" or "groups/<id>" URL segment for the
+// grantee of a capability.
+func granteePath(grantee model.GranteeCapabilities) (string, error) {
+	switch {
+	case grantee.User != nil && grantee.User.ID != "":
+		return "users/" + grantee.User.ID, nil
+	case grantee.Group != nil && grantee.Group.ID != "":
+		return "groups/" + grantee.Group.ID, nil
+	}
+	return "", errors.New("Grantee must be a user or group ID")
+}
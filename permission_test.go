@@ -0,0 +1,71 @@
+package gotabgo
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+func TestPermissionEndpoints(t *testing.T) {
+	const perms = `<permissions>
+		<%s id="c1"/>
+		<granteeCapabilities>
+			<group id="g1"/>
+			<capabilities><capability name="Read" mode="Allow"/><capability name="Write" mode="Deny"/></capabilities>
+		</granteeCapabilities>
+	</permissions>`
+	grant := model.GranteeCapabilities{
+		Group: &model.Group{ID: "g1"},
+		Capabilities: model.Capabilities{Capability: []model.Capability{
+			{Name: "Read", Mode: "Allow"},
+		}},
+	}
+	runEndpointTests(t, []endpointTest{
+		{
+			name: "QueryPermissions datasource", status: http.StatusOK, resp: fmt.Sprintf(perms, "datasource"),
+			call: func(api *TabApi) (string, error) {
+				p, err := api.QueryPermissions(model.ResourceDatasources, "c1")
+				if err != nil {
+					return "", err
+				}
+				caps := p.GranteeCapabilities[0].Capabilities.Capability
+				return fmt.Sprint(p.Datasource.ID, " ", p.GranteeCapabilities[0].Group.ID, " ", caps[1].Name, ":", caps[1].Mode), nil
+			},
+			method: http.MethodGet, url: "datasources/c1/permissions", want: "c1 g1 Write:Deny",
+		},
+		{
+			name: "QueryPermissions flow", status: http.StatusOK, resp: fmt.Sprintf(perms, "flow"),
+			call: func(api *TabApi) (string, error) {
+				p, err := api.QueryPermissions(model.ResourceFlows, "c1")
+				if err != nil {
+					return "", err
+				}
+				return p.Flow.ID, nil
+			},
+			method: http.MethodGet, url: "flows/c1/permissions", want: "c1",
+		},
+		{
+			name: "AddPermissions", status: http.StatusOK, resp: fmt.Sprintf(perms, "workbook"),
+			call: func(api *TabApi) (string, error) {
+				p, err := api.AddPermissions(model.ResourceWorkbooks, "c1", []model.GranteeCapabilities{grant})
+				if err != nil {
+					return "", err
+				}
+				return p.Workbook.ID, nil
+			},
+			method: http.MethodPut, url: "workbooks/c1/permissions",
+			payload: `<permissions><granteeCapabilities><group id="g1"></group><capabilities><capability name="Read" mode="Allow"></capability></capabilities></granteeCapabilities></permissions>`,
+			want:    "c1",
+		},
+		{
+			name: "DeletePermission", status: http.StatusNoContent,
+			call: func(api *TabApi) (string, error) {
+				grantee := model.GranteeCapabilities{User: &model.User{ID: "u1"}}
+				return "", api.DeletePermission(model.ResourceProjects, "c1", grantee, model.Capability{Name: "Read", Mode: "Allow"})
+			},
+			method: http.MethodDelete, url: "projects/c1/permissions/users/u1/Read/Allow",
+		},
+	})
+}
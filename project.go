@@ -0,0 +1,175 @@
+package gotabgo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/groundfoundation/gotabgo/model"
+	log "github.com/sirupsen/logrus"
+)
+
+// CreateProject creates a project on the current site. Set ParentProjectID to
+// nest it under an existing project.
+func (t *TabApi) CreateProject(project model.Project) (p *model.Project, err error) {
+	url := fmt.Sprintf("%s/projects", t.getSiteUrl())
+	tsRequest := model.TsRequest{Project: &project}
+	tResponse, err := t.send("CreateProject", http.MethodPost, url, &tsRequest, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Project, nil
+}
+
+// QueryProjects returns one page of the projects on the current site.
+func (t *TabApi) QueryProjects(opts *QueryOptions) (p []model.Project, page model.Pagination, err error) {
+	url := fmt.Sprintf("%s/projects%s", t.getSiteUrl(), opts.query())
+	tResponse, err := t.send("QueryProjects", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, page, err
+	}
+	if tResponse.Projects != nil {
+		p = tResponse.Projects.Project
+	}
+
+	return p, tResponse.Pagination, nil
+}
+
+// QueryAllProjects walks every page of QueryProjects.
+func (t *TabApi) QueryAllProjects(opts *QueryOptions) (p []model.Project, err error) {
+	for {
+		projects, page, err := t.QueryProjects(opts)
+		if err != nil {
+			return nil, err
+		}
+		p = append(p, projects...)
+		if len(projects) == 0 || !page.HasMore() {
+			return p, nil
+		}
+		opts = opts.nextPage(page)
+	}
+}
+
+// UpdateProject changes the name, description, parent or content permissions
+// of the project identified by project.ID.
+func (t *TabApi) UpdateProject(project model.Project) (p *model.Project, err error) {
+	if project.ID == "" {
+		return nil, errors.New("Project ID is required")
+	}
+	url := fmt.Sprintf("%s/projects/%s", t.getSiteUrl(), project.ID)
+	update := project
+	update.ID = ""
+	tsRequest := model.TsRequest{Project: &update}
+	tResponse, err := t.send("UpdateProject", http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Project, nil
+}
+
+// DeleteProject removes a project and all of the content inside it.
+func (t *TabApi) DeleteProject(id string) (err error) {
+	url := fmt.Sprintf("%s/projects/%s", t.getSiteUrl(), id)
+	_, err = t.send("DeleteProject", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
+
+// ProjectNode is a project and its nested projects.
+type ProjectNode struct {
+	Project  model.Project
+	Parent   *ProjectNode
+	Children []*ProjectNode
+}
+
+// Path returns the slash separated names from the top level project down to n.
+func (n *ProjectNode) Path() string {
+	if n.Parent == nil {
+		return n.Project.Name
+	}
+	return n.Parent.Path() + "/" + n.Project.Name
+}
+
+// ProjectTree arranges the flat project listing of a site by parentProjectId.
+type ProjectTree struct {
+	Roots []*ProjectNode
+	byID  map[string]*ProjectNode
+}
+
+// NewProjectTree assembles projects into a tree. Projects whose parent is not
+// in the listing are treated as top level projects.
+func NewProjectTree(projects []model.Project) *ProjectTree {
+	pt := &ProjectTree{byID: make(map[string]*ProjectNode, len(projects))}
+	for _, p := range projects {
+		pt.byID[p.ID] = &ProjectNode{Project: p}
+	}
+	for _, p := range projects {
+		node := pt.byID[p.ID]
+		parent, ok := pt.byID[p.ParentProjectID]
+		if p.ParentProjectID == "" || !ok {
+			pt.Roots = append(pt.Roots, node)
+			continue
+		}
+		node.Parent = parent
+		parent.Children = append(parent.Children, node)
+	}
+
+	return pt
+}
+
+// Get returns the node for the project with the given ID.
+func (pt *ProjectTree) Get(id string) (*ProjectNode, bool) {
+	n, ok := pt.byID[id]
+	return n, ok
+}
+
+// Find resolves a path such as "Finance/Monthly/Close" to its project node.
+func (pt *ProjectTree) Find(path string) (*ProjectNode, error) {
+	names := strings.Split(strings.Trim(path, "/"), "/")
+	level := pt.Roots
+	var found *ProjectNode
+	for _, name := range names {
+		found = nil
+		for _, n := range level {
+			if n.Project.Name == name {
+				found = n
+				break
+			}
+		}
+		if found == nil {
+			return nil, fmt.Errorf("Project Not Found: %s", path)
+		}
+		level = found.Children
+	}
+
+	return found, nil
+}
+
+// ProjectTree fetches every project on the current site and assembles them
+// into a tree.
+func (t *TabApi) ProjectTree() (pt *ProjectTree, err error) {
+	projects, err := t.QueryAllProjects(nil)
+	if err != nil {
+		return nil, err
+	}
+	log.WithField("method", "ProjectTree").Debugf("projects: %d", len(projects))
+
+	return NewProjectTree(projects), nil
+}
+
+// ResolveProjectPath returns the ID of the project at path, for example
+// "Finance/Monthly/Close".
+func (t *TabApi) ResolveProjectPath(path string) (id string, err error) {
+	pt, err := t.ProjectTree()
+	if err != nil {
+		return "", err
+	}
+	n, err := pt.Find(path)
+	if err != nil {
+		return "", err
+	}
+
+	return n.Project.ID, nil
+}
@@ -0,0 +1,83 @@
+package gotabgo
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+func TestProjectTree(t *testing.T) {
+	pt := NewProjectTree([]model.Project{
+		{ID: "3", Name: "Close", ParentProjectID: "2"},
+		{ID: "1", Name: "Finance"},
+		{ID: "2", Name: "Monthly", ParentProjectID: "1"},
+		{ID: "4", Name: "Orphan", ParentProjectID: "missing"},
+		{ID: "5", Name: "Monthly"},
+	})
+
+	if len(pt.Roots) != 3 {
+		t.Fatalf("roots = %d, want 3", len(pt.Roots))
+	}
+	n, err := pt.Find("/Finance/Monthly/Close/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.Project.ID != "3" {
+		t.Errorf("Find returned project %s, want 3", n.Project.ID)
+	}
+	if got := n.Path(); got != "Finance/Monthly/Close" {
+		t.Errorf("Path = %q", got)
+	}
+	if n, err = pt.Find("Monthly"); err != nil || n.Project.ID != "5" {
+		t.Errorf("Find(Monthly) = %v, %v, want top level project 5", n, err)
+	}
+	if _, err = pt.Find("Finance/Close"); err == nil {
+		t.Error("Find(Finance/Close) should fail")
+	}
+	if n, ok := pt.Get("2"); !ok || len(n.Children) != 1 {
+		t.Errorf("Get(2) = %v, %v", n, ok)
+	}
+}
+
+func TestQueryAllProjectsStops(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"no page size", `<pagination pageNumber="1" totalAvailable="10"/><projects><project id="1" name="a"/></projects>`},
+		{"empty page", `<pagination pageNumber="1" pageSize="1" totalAvailable="10"/><projects/>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			api := newTestApi(t, func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				writeXml(w, tt.body)
+			})
+			if _, err := api.QueryAllProjects(nil); err != nil {
+				t.Fatal(err)
+			}
+			if calls != 1 {
+				t.Errorf("made %d requests, want 1", calls)
+			}
+		})
+	}
+}
+
+func TestQueryAllProjectsPages(t *testing.T) {
+	api := newTestApi(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("pageNumber") == "2" {
+			writeXml(w, `<pagination pageNumber="2" pageSize="1" totalAvailable="2"/><projects><project id="2"/></projects>`)
+			return
+		}
+		writeXml(w, `<pagination pageNumber="1" pageSize="1" totalAvailable="2"/><projects><project id="1"/></projects>`)
+	})
+	p, err := api.QueryAllProjects(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p) != 2 || p[0].ID != "1" || p[1].ID != "2" {
+		t.Errorf("projects = %v", p)
+	}
+}
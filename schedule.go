@@ -0,0 +1,150 @@
+package gotabgo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+// getSchedulesUrl returns the server wide schedules endpoint. Schedules are
+// not scoped to a site.
+func (t *TabApi) getSchedulesUrl() string {
+	return fmt.Sprintf("%s/api/%s/schedules", t.getUrl(), t.ApiVersion)
+}
+
+// CreateSchedule creates an extract, flow or subscription schedule. The
+// frequency details carry the start time and intervals for the frequency.
+func (t *TabApi) CreateSchedule(schedule model.Schedule) (s *model.Schedule, err error) {
+	if schedule.Name == "" || schedule.Frequency == "" || schedule.FrequencyDetails == nil {
+		return nil, errors.New("Schedule name, frequency and frequency details are required")
+	}
+	schedule.ID = ""
+	tsRequest := model.TsRequest{Schedule: &schedule}
+	tResponse, err := t.send("CreateSchedule", http.MethodPost, t.getSchedulesUrl(), &tsRequest, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Schedule, nil
+}
+
+// UpdateSchedule changes the schedule identified by schedule.ID. Empty fields
+// are left unchanged; FrequencyDetails must be set when Frequency is.
+func (t *TabApi) UpdateSchedule(schedule model.Schedule) (s *model.Schedule, err error) {
+	if schedule.ID == "" {
+		return nil, errors.New("Schedule ID is required")
+	}
+	url := fmt.Sprintf("%s/%s", t.getSchedulesUrl(), schedule.ID)
+	update := schedule
+	update.ID = ""
+	tsRequest := model.TsRequest{Schedule: &update}
+	tResponse, err := t.send("UpdateSchedule", http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Schedule, nil
+}
+
+// DeleteSchedule removes a schedule along with the tasks that use it.
+func (t *TabApi) DeleteSchedule(id string) (err error) {
+	url := fmt.Sprintf("%s/%s", t.getSchedulesUrl(), id)
+	_, err = t.send("DeleteSchedule", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
+
+// QuerySchedules returns one page of the schedules on the server.
+func (t *TabApi) QuerySchedules(opts *QueryOptions) (s []model.Schedule, page model.Pagination, err error) {
+	url := t.getSchedulesUrl() + opts.query()
+	tResponse, err := t.send("QuerySchedules", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, page, err
+	}
+	if tResponse.Schedules != nil {
+		s = tResponse.Schedules.Schedule
+	}
+
+	return s, tResponse.Pagination, nil
+}
+
+// QueryExtractRefreshTasks lists the extract refresh tasks on the current site.
+func (t *TabApi) QueryExtractRefreshTasks() (tasks []model.ExtractRefresh, err error) {
+	url := fmt.Sprintf("%s/tasks/extractRefreshes", t.getSiteUrl())
+	tResponse, err := t.send("QueryExtractRefreshTasks", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	if tResponse.Tasks != nil {
+		for _, task := range tResponse.Tasks.Task {
+			if task.ExtractRefresh != nil {
+				tasks = append(tasks, *task.ExtractRefresh)
+			}
+		}
+	}
+
+	return tasks, nil
+}
+
+// QueryExtractRefreshTask returns a single extract refresh task.
+func (t *TabApi) QueryExtractRefreshTask(id string) (task *model.ExtractRefresh, err error) {
+	url := fmt.Sprintf("%s/tasks/extractRefreshes/%s", t.getSiteUrl(), id)
+	tResponse, err := t.send("QueryExtractRefreshTask", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	if tResponse.Task == nil || tResponse.Task.ExtractRefresh == nil {
+		return nil, errors.New("Task Not Found on site")
+	}
+
+	return tResponse.Task.ExtractRefresh, nil
+}
+
+// AddWorkbookToSchedule creates an extract refresh task for a workbook on an
+// extract schedule.
+func (t *TabApi) AddWorkbookToSchedule(scheduleID, workbookID string) (task *model.ExtractRefresh, err error) {
+	url := fmt.Sprintf("%s/schedules/%s/workbooks", t.getSiteUrl(), scheduleID)
+	refresh := model.ExtractRefresh{Workbook: &model.Workbook{ID: workbookID}}
+	return t.addToSchedule("AddWorkbookToSchedule", url, refresh)
+}
+
+// AddDatasourceToSchedule creates an extract refresh task for a data source on
+// an extract schedule.
+func (t *TabApi) AddDatasourceToSchedule(scheduleID, datasourceID string) (task *model.ExtractRefresh, err error) {
+	url := fmt.Sprintf("%s/schedules/%s/datasources", t.getSiteUrl(), scheduleID)
+	refresh := model.ExtractRefresh{Datasource: &model.Datasource{ID: datasourceID}}
+	return t.addToSchedule("AddDatasourceToSchedule", url, refresh)
+}
+
+func (t *TabApi) addToSchedule(caller, url string, refresh model.ExtractRefresh) (task *model.ExtractRefresh, err error) {
+	tsRequest := model.TsRequest{Task: &model.Task{ExtractRefresh: &refresh}}
+	tResponse, err := t.send(caller, http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	if tResponse.Task == nil {
+		return nil, nil
+	}
+
+	return tResponse.Task.ExtractRefresh, nil
+}
+
+// RunExtractRefreshTask starts an extract refresh task immediately and returns
+// the job that tracks it.
+func (t *TabApi) RunExtractRefreshTask(id string) (job *model.Job, err error) {
+	url := fmt.Sprintf("%s/tasks/extractRefreshes/%s/runNow", t.getSiteUrl(), id)
+	tResponse, err := t.send("RunExtractRefreshTask", http.MethodPost, url, &model.TsRequest{}, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Job, nil
+}
+
+// DeleteExtractRefreshTask removes an extract refresh task from its schedule.
+func (t *TabApi) DeleteExtractRefreshTask(id string) (err error) {
+	url := fmt.Sprintf("%s/tasks/extractRefreshes/%s", t.getSiteUrl(), id)
+	_, err = t.send("DeleteExtractRefreshTask", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
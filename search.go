@@ -0,0 +1,102 @@
+package gotabgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/groundfoundation/gotabgo/model"
+	log "github.com/sirupsen/logrus"
+)
+
+// SearchOptions narrows a content search. Types takes the model.SearchType
+// constants and Order an order_by expression such as "hitsTotal:desc".
+type SearchOptions struct {
+	Terms string
+	Types []string
+	Order string
+	Limit int
+	Page  int
+}
+
+// Search runs a free text search over the content of the current site.
+func (t *TabApi) Search(opts SearchOptions) (r *model.SearchResults, err error) {
+	v := url.Values{}
+	v.Set("terms", opts.Terms)
+	if len(opts.Types) == 1 {
+		v.Set("filter", "type:eq:"+opts.Types[0])
+	} else if len(opts.Types) > 1 {
+		v.Set("filter", "type:in:["+strings.Join(opts.Types, ",")+"]")
+	}
+	if opts.Order != "" {
+		v.Set("order_by", opts.Order)
+	}
+	if opts.Limit > 0 {
+		v.Set("limit", strconv.Itoa(opts.Limit))
+	}
+	if opts.Page > 0 {
+		v.Set("page", strconv.Itoa(opts.Page))
+	}
+	u := fmt.Sprintf("%s/api/-/search?%s", t.getUrl(), v.Encode())
+	log.WithField("method", "Search").Debug("url: ", u)
+
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", Json.String())
+	resp, err := t.c.Do(req)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, &ApiError{resp.StatusCode, resp.Status}
+	}
+
+	var body struct {
+		Hits model.SearchResults `json:"hits"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	return &body.Hits, nil
+}
+
+// ResolveSearchItem fetches the full workbook, view or data source a search
+// hit refers to. The result is a *model.Workbook, *model.View or
+// *model.Datasource.
+func (t *TabApi) ResolveSearchItem(item model.SearchItem) (content interface{}, err error) {
+	// Each case checks err itself so that a failed lookup returns a nil
+	// interface rather than a typed nil pointer.
+	switch item.Type {
+	case model.SearchTypeWorkbook:
+		w, err := t.QueryWorkbook(item.Content.Luid)
+		if err != nil {
+			return nil, err
+		}
+		return w, nil
+	case model.SearchTypeView:
+		v, err := t.GetViewById(item.Content.Luid)
+		if err != nil {
+			return nil, err
+		}
+		if v == nil {
+			return nil, errors.New("View Not Found on site")
+		}
+		return v, nil
+	case model.SearchTypeDatasource:
+		d, err := t.QueryDatasource(item.Content.Luid)
+		if err != nil {
+			return nil, err
+		}
+		return d, nil
+	}
+	return nil, fmt.Errorf("Search results of type %s can't be resolved", item.Type)
+}
@@ -0,0 +1,39 @@
+package gotabgo
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+func TestSearchQuery(t *testing.T) {
+	var query string
+	api := newTestApi(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		w.Header().Set("Content-Type", Json.String())
+		w.Write([]byte(`{"hits":{"total":0,"items":[]}}`))
+	})
+	_, err := api.Search(SearchOptions{Terms: "sales", Types: []string{"workbook"}, Order: "hitsTotal:desc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "filter=type%3Aeq%3Aworkbook&order_by=hitsTotal%3Adesc&terms=sales"
+	if query != want {
+		t.Errorf("query = %q, want %q", query, want)
+	}
+}
+
+func TestResolveSearchItemError(t *testing.T) {
+	api := newTestApi(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", Xml.String())
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`<tsResponse xmlns="http://tableau.com/api"><error code="404006"><summary>Not found</summary></error></tsResponse>`))
+	})
+	for _, typ := range []string{model.SearchTypeWorkbook, model.SearchTypeView, model.SearchTypeDatasource} {
+		content, err := api.ResolveSearchItem(model.SearchItem{Type: typ, Content: model.SearchContent{Luid: "x"}})
+		if err == nil || content != nil {
+			t.Errorf("%s: content = %#v, err = %v, want nil content and an error", typ, content, err)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package gotabgo
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+// GetCurrentServerSession returns the user, site and session details of the
+// signed in session.
+func (t *TabApi) GetCurrentServerSession() (s *model.Session, err error) {
+	url := fmt.Sprintf("%s/api/%s/sessions/current", t.getUrl(), t.ApiVersion)
+	tResponse, err := t.send("GetCurrentServerSession", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Session, nil
+}
+
+// DeleteServerSession signs out the session with the given ID, such as one
+// returned by GetCurrentServerSession. It requires a server administrator.
+func (t *TabApi) DeleteServerSession(id string) (err error) {
+	url := fmt.Sprintf("%s/api/%s/sessions/%s", t.getUrl(), t.ApiVersion, id)
+	_, err = t.send("DeleteServerSession", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
+
+// GetCurrentUser returns the signed in user. The user ID returned by Signin is
+// used when available, otherwise it is read from the current session.
+func (t *TabApi) GetCurrentUser() (u *model.User, err error) {
+	if t.UserID == "" {
+		s, err := t.GetCurrentServerSession()
+		if err != nil {
+			return nil, err
+		}
+		if s == nil || s.User == nil {
+			return nil, fmt.Errorf("Current session has no user")
+		}
+		t.UserID = s.User.ID
+	}
+
+	return t.QueryUser(t.UserID)
+}
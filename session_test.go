@@ -0,0 +1,61 @@
+package gotabgo
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestSessionEndpoints(t *testing.T) {
+	const session = `<session sessionId="s1" createdAt="2024-01-01T00:00:00Z">
+		<site id="site-1" name="Default" contentUrl=""/>
+		<user id="u1" name="ann"/>
+	</session>`
+	runEndpointTests(t, []endpointTest{
+		{
+			name: "GetCurrentServerSession", status: http.StatusOK, resp: session,
+			call: func(api *TabApi) (string, error) {
+				s, err := api.GetCurrentServerSession()
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprint(s.SessionID, " ", s.Site.ID, " ", s.User.Name), nil
+			},
+			method: http.MethodGet, url: "/api/3.18/sessions/current", want: "s1 site-1 ann",
+		},
+		{
+			name: "DeleteServerSession", status: http.StatusNoContent,
+			call: func(api *TabApi) (string, error) {
+				return "", api.DeleteServerSession("s1")
+			},
+			method: http.MethodDelete, url: "/api/3.18/sessions/s1",
+		},
+		{
+			name: "GetCurrentUser signed in", status: http.StatusOK,
+			resp: `<user id="u2" name="bob"/>`,
+			call: func(api *TabApi) (string, error) {
+				api.UserID = "u2"
+				u, err := api.GetCurrentUser()
+				if err != nil {
+					return "", err
+				}
+				return u.Name, nil
+			},
+			method: http.MethodGet, url: "users/u2", want: "bob",
+		},
+		{
+			// The stub answers both the session lookup and the user query,
+			// so the last request seen is the user query.
+			name: "GetCurrentUser from session", status: http.StatusOK,
+			resp: session + `<user id="u1" name="ann" siteRole="Creator"/>`,
+			call: func(api *TabApi) (string, error) {
+				u, err := api.GetCurrentUser()
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprint(api.UserID, " ", u.SiteRole), nil
+			},
+			method: http.MethodGet, url: "users/u1", want: "u1 Creator",
+		},
+	})
+}
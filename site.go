@@ -0,0 +1,121 @@
+package gotabgo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/groundfoundation/gotabgo/model"
+	log "github.com/sirupsen/logrus"
+)
+
+// SiteKey selects how QuerySite and DeleteSite interpret the site identifier
+type SiteKey string
+
+const (
+	SiteByID         SiteKey = ""
+	SiteByName       SiteKey = "name"
+	SiteByContentUrl SiteKey = "contentUrl"
+)
+
+// siteUrl returns the endpoint of the site identified by value under key.
+func (t *TabApi) siteUrl(key SiteKey, value string) string {
+	u := fmt.Sprintf("%s/api/%s/sites/%s", t.getUrl(), t.ApiVersion, url.PathEscape(value))
+	if key != SiteByID {
+		u += "?key=" + string(key)
+	}
+	return u
+}
+
+// QuerySitesPage returns one page of the sites on the server. Unlike
+// QuerySites, which only sees the first page, it reports the pagination so
+// the rest can be fetched.
+func (t *TabApi) QuerySitesPage(opts *QueryOptions) (s []model.SiteType, page model.Pagination, err error) {
+	url := fmt.Sprintf("%s/api/%s/sites%s", t.getUrl(), t.ApiVersion, opts.query())
+	tResponse, err := t.send("QuerySitesPage", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, page, err
+	}
+	if tResponse.Sites != nil {
+		s = tResponse.Sites.Site
+	}
+
+	return s, tResponse.Pagination, nil
+}
+
+// QueryAllSites walks every page of QuerySitesPage.
+func (t *TabApi) QueryAllSites(opts *QueryOptions) (s []model.SiteType, err error) {
+	for {
+		sites, page, err := t.QuerySitesPage(opts)
+		if err != nil {
+			return nil, err
+		}
+		s = append(s, sites...)
+		if len(sites) == 0 || !page.HasMore() {
+			return s, nil
+		}
+		opts = opts.nextPage(page)
+	}
+}
+
+// QuerySite returns a single site looked up by ID, name or content URL.
+func (t *TabApi) QuerySite(key SiteKey, value string) (st *model.SiteType, err error) {
+	tResponse, err := t.send("QuerySite", http.MethodGet, t.siteUrl(key, value), nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tResponse.Site, nil
+}
+
+// UpdateSite changes the settings of the site identified by site.ID, such as
+// its quotas, admin mode, revision history, subscription settings or state.
+// Setting State to model.SiteStateSuspended suspends the site and
+// model.SiteStateActive reactivates it.
+func (t *TabApi) UpdateSite(site model.SiteType) (st *model.SiteType, err error) {
+	if site.ID == "" {
+		return nil, errors.New("Site ID is required")
+	}
+	url := t.siteUrl(SiteByID, site.ID)
+	update := site
+	update.ID = ""
+	update.Usage = nil
+	tsRequest := model.TsRequest{Site: &update}
+	tResponse, err := t.send("UpdateSite", http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tResponse.Site, nil
+}
+
+// DeleteSite removes a site and all of its content. The site is identified by
+// ID, name or content URL.
+func (t *TabApi) DeleteSite(key SiteKey, value string) (err error) {
+	_, err = t.send("DeleteSite", http.MethodDelete, t.siteUrl(key, value), nil, http.StatusNoContent)
+	return
+}
+
+// SwitchSite moves the signed in session to the site with the given content
+// URL, empty for the default site. The auth token and SiteID are replaced with
+// those for the new site, so there is no need to sign in again.
+func (t *TabApi) SwitchSite(contentUrl string) (err error) {
+	url := fmt.Sprintf("%s/api/%s/auth/switchSite", t.getUrl(), t.ApiVersion)
+	tsRequest := model.SwitchSiteRequest{Site: model.SiteRef{ContentUrl: contentUrl}}
+	tResponse, err := t.sendRequest("SwitchSite", http.MethodPost, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return err
+	}
+	if tResponse.Credentials.Token == "" || tResponse.Credentials.Site == nil {
+		return errors.New("Switch site returned no credentials")
+	}
+	t.c.authToken = tResponse.Credentials.Token
+	t.SiteID = tResponse.Credentials.Site.ID
+	if tResponse.Credentials.Impersonate != nil {
+		t.UserID = tResponse.Credentials.Impersonate.ID
+	}
+	log.WithField("method", "SwitchSite").Debug("SiteID: ", t.SiteID)
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package gotabgo
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestSiteUrlEscapes(t *testing.T) {
+	api, _ := NewTabApi("tableau.example.com", "3.9", true, Xml)
+	got := api.siteUrl(SiteByName, "R&D #2/EU")
+	want := "https://tableau.example.com/api/3.9/sites/R&D%20%232%2FEU?key=name"
+	if got != want {
+		t.Errorf("siteUrl = %q, want %q", got, want)
+	}
+}
+
+func TestSwitchSiteDefault(t *testing.T) {
+	var body string
+	api := newTestApi(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		writeXml(w, `<credentials token="t2"><site id="default-id" contentUrl=""/><user id="u1"/></credentials>`)
+	})
+	if err := api.SwitchSite(""); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(body, `<site contentUrl="">`) {
+		t.Errorf("request %s does not name the default site", body)
+	}
+	if api.SiteID != "default-id" || api.UserID != "u1" || api.c.authToken != "t2" {
+		t.Errorf("session not switched: %+v", api)
+	}
+}
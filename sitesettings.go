@@ -0,0 +1,128 @@
+package gotabgo
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+const (
+	embeddingSettingsApiVer = "3.16"
+	siteOIDCApiVer          = "3.22"
+	siteAuthApiVer          = "3.24"
+)
+
+// QuerySiteAuthConfigurations lists the authentication methods enabled on the
+// current site, including SAML and OpenID Connect identity providers.
+func (t *TabApi) QuerySiteAuthConfigurations() (c []model.SiteAuthConfiguration, err error) {
+	if err = t.requireApiVersion("QuerySiteAuthConfigurations", siteAuthApiVer); err != nil {
+		return nil, err
+	}
+	url := fmt.Sprintf("%s/site-auth-configurations", t.getSiteUrl())
+	tResponse, err := t.send("QuerySiteAuthConfigurations", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	if tResponse.SiteAuthConfigurations != nil {
+		c = tResponse.SiteAuthConfigurations.SiteAuthConfiguration
+	}
+
+	return c, nil
+}
+
+// QuerySiteSAMLConfigurations returns the SAML identity providers enabled on
+// the current site. SAML settings are read only here: the REST API has no
+// endpoint to change a site's SAML configuration, which is managed in the
+// site settings page or with TSM instead. Only OpenID Connect can be
+// updated, with UpdateSiteOIDCConfiguration.
+func (t *TabApi) QuerySiteSAMLConfigurations() (c []model.SiteAuthConfiguration, err error) {
+	configs, err := t.QuerySiteAuthConfigurations()
+	if err != nil {
+		return nil, err
+	}
+	for _, ac := range configs {
+		if ac.AuthSetting == model.AuthSettingSAML {
+			c = append(c, ac)
+		}
+	}
+
+	return c, nil
+}
+
+// GetEmbeddingSettings returns whether the current site can be embedded on
+// any domain and, if not, the domains it is allowed on.
+func (t *TabApi) GetEmbeddingSettings() (unrestricted bool, domains []string, err error) {
+	if err = t.requireApiVersion("GetEmbeddingSettings", embeddingSettingsApiVer); err != nil {
+		return false, nil, err
+	}
+	url := fmt.Sprintf("%s/settings/embedding", t.getSiteUrl())
+	tResponse, err := t.send("GetEmbeddingSettings", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return false, nil, err
+	}
+	if tResponse.Site.UnrestrictedEmbedding != nil {
+		unrestricted = *tResponse.Site.UnrestrictedEmbedding
+	}
+
+	return unrestricted, strings.Fields(tResponse.Site.AllowList), nil
+}
+
+// UpdateEmbeddingSettings allows embedding the current site on any domain, or
+// when unrestricted is false, only on the given domains. Domains may use a
+// leading wildcard such as "*.example.com".
+func (t *TabApi) UpdateEmbeddingSettings(unrestricted bool, domains []string) (err error) {
+	if err = t.requireApiVersion("UpdateEmbeddingSettings", embeddingSettingsApiVer); err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%s/settings/embedding", t.getSiteUrl())
+	tsRequest := model.TsRequest{Site: &model.SiteType{
+		UnrestrictedEmbedding: &unrestricted,
+		AllowList:             strings.Join(domains, " "),
+	}}
+	_, err = t.send("UpdateEmbeddingSettings", http.MethodPut, url, &tsRequest, http.StatusOK)
+	return
+}
+
+// GetSiteOIDCConfiguration returns the OpenID Connect configuration of the
+// current site.
+func (t *TabApi) GetSiteOIDCConfiguration() (c *model.SiteOIDCConfiguration, err error) {
+	if err = t.requireApiVersion("GetSiteOIDCConfiguration", siteOIDCApiVer); err != nil {
+		return nil, err
+	}
+	url := fmt.Sprintf("%s/site-oidc-configuration", t.getSiteUrl())
+	tResponse, err := t.send("GetSiteOIDCConfiguration", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.SiteOIDCConfiguration, nil
+}
+
+// UpdateSiteOIDCConfiguration creates or replaces the OpenID Connect
+// configuration of the current site.
+func (t *TabApi) UpdateSiteOIDCConfiguration(config model.SiteOIDCConfiguration) (c *model.SiteOIDCConfiguration, err error) {
+	if err = t.requireApiVersion("UpdateSiteOIDCConfiguration", siteOIDCApiVer); err != nil {
+		return nil, err
+	}
+	url := fmt.Sprintf("%s/site-oidc-configuration", t.getSiteUrl())
+	tsRequest := model.TsRequest{SiteOIDCConfiguration: &config}
+	tResponse, err := t.send("UpdateSiteOIDCConfiguration", http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.SiteOIDCConfiguration, nil
+}
+
+// RemoveSiteOIDCConfiguration removes an OpenID Connect configuration from the
+// current site.
+func (t *TabApi) RemoveSiteOIDCConfiguration(idpConfigurationID string) (err error) {
+	if err = t.requireApiVersion("RemoveSiteOIDCConfiguration", siteOIDCApiVer); err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%s/site-oidc-configuration/%s/remove", t.getSiteUrl(), idpConfigurationID)
+	_, err = t.send("RemoveSiteOIDCConfiguration", http.MethodPut, url, nil, http.StatusNoContent)
+	return
+}
@@ -0,0 +1,108 @@
+package gotabgo
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+// withApiVersion points api at REST API ver and records a server that
+// supports it, so the version gate does not call ServerInfo.
+func withApiVersion(api *TabApi, ver string) *TabApi {
+	api.ApiVersion = ver
+	api.serverApiVersion = ver
+	return api
+}
+
+func TestSiteSettingsEndpoints(t *testing.T) {
+	const oidc = `<siteOIDCConfiguration idpConfigurationId="idp1" enabled="true" clientId="abc"
+		authorizationEndpoint="https://idp.example.com/auth"/>`
+	runEndpointTests(t, []endpointTest{
+		{
+			name: "QuerySiteAuthConfigurations", status: http.StatusOK,
+			resp: `<siteAuthConfigurations>
+				<siteAuthConfiguration authSetting="ServerDefault" enabled="true"/>
+				<siteAuthConfiguration authSetting="SAML" enabled="true" idpConfigurationId="idp2" idpConfigurationName="Okta"/>
+			</siteAuthConfigurations>`,
+			call: func(api *TabApi) (string, error) {
+				c, err := withApiVersion(api, "3.24").QuerySiteAuthConfigurations()
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprint(len(c), " ", c[1].IdpConfigurationName), nil
+			},
+			method: http.MethodGet, url: "/api/3.24/sites/site-1/site-auth-configurations", want: "2 Okta",
+		},
+		{
+			name: "QuerySiteSAMLConfigurations", status: http.StatusOK,
+			resp: `<siteAuthConfigurations>
+				<siteAuthConfiguration authSetting="ServerDefault" enabled="true"/>
+				<siteAuthConfiguration authSetting="SAML" enabled="true" idpConfigurationId="idp2"/>
+			</siteAuthConfigurations>`,
+			call: func(api *TabApi) (string, error) {
+				c, err := withApiVersion(api, "3.24").QuerySiteSAMLConfigurations()
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprint(len(c), " ", c[0].IdpConfigurationID), nil
+			},
+			method: http.MethodGet, url: "/api/3.24/sites/site-1/site-auth-configurations", want: "1 idp2",
+		},
+		{
+			name: "GetEmbeddingSettings", status: http.StatusOK,
+			resp: `<site unrestrictedEmbedding="false" allowList="example.com *.example.org"/>`,
+			call: func(api *TabApi) (string, error) {
+				unrestricted, domains, err := withApiVersion(api, "3.18").GetEmbeddingSettings()
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprint(unrestricted, " ", domains), nil
+			},
+			method: http.MethodGet, url: "settings/embedding", want: "false [example.com *.example.org]",
+		},
+		{
+			name: "UpdateEmbeddingSettings", status: http.StatusOK,
+			resp: `<site unrestrictedEmbedding="false" allowList="example.com"/>`,
+			call: func(api *TabApi) (string, error) {
+				return "", withApiVersion(api, "3.18").UpdateEmbeddingSettings(false, []string{"example.com", "*.example.org"})
+			},
+			method: http.MethodPut, url: "settings/embedding",
+			payload: `<site unrestrictedEmbedding="false" allowList="example.com *.example.org"></site>`,
+		},
+		{
+			name: "GetSiteOIDCConfiguration", status: http.StatusOK, resp: oidc,
+			call: func(api *TabApi) (string, error) {
+				c, err := withApiVersion(api, "3.22").GetSiteOIDCConfiguration()
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprint(c.ClientID, " ", *c.Enabled), nil
+			},
+			method: http.MethodGet, url: "/api/3.22/sites/site-1/site-oidc-configuration", want: "abc true",
+		},
+		{
+			name: "UpdateSiteOIDCConfiguration", status: http.StatusOK, resp: oidc,
+			call: func(api *TabApi) (string, error) {
+				c, err := withApiVersion(api, "3.22").UpdateSiteOIDCConfiguration(model.SiteOIDCConfiguration{
+					ClientID: "abc", ClientSecret: "s3cret",
+				})
+				if err != nil {
+					return "", err
+				}
+				return c.IdpConfigurationID, nil
+			},
+			method: http.MethodPut, url: "/api/3.22/sites/site-1/site-oidc-configuration",
+			payload: `<siteOIDCConfiguration clientId="abc" clientSecret="s3cret"></siteOIDCConfiguration>`,
+			want:    "idp1",
+		},
+		{
+			name: "RemoveSiteOIDCConfiguration", status: http.StatusNoContent,
+			call: func(api *TabApi) (string, error) {
+				return "", withApiVersion(api, "3.22").RemoveSiteOIDCConfiguration("idp1")
+			},
+			method: http.MethodPut, url: "/api/3.22/sites/site-1/site-oidc-configuration/idp1/remove",
+		},
+	})
+}
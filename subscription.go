@@ -0,0 +1,77 @@
+package gotabgo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+// CreateSubscription subscribes a user to a view or workbook on a
+// subscription schedule.
+func (t *TabApi) CreateSubscription(subscription model.Subscription) (s *model.Subscription, err error) {
+	if subscription.Content == nil || subscription.Schedule == nil || subscription.User == nil {
+		return nil, errors.New("Subscription content, schedule and user are required")
+	}
+	url := fmt.Sprintf("%s/subscriptions", t.getSiteUrl())
+	subscription.ID = ""
+	tsRequest := model.TsRequest{Subscription: &subscription}
+	tResponse, err := t.send("CreateSubscription", http.MethodPost, url, &tsRequest, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Subscription, nil
+}
+
+// QuerySubscriptions returns one page of the subscriptions on the current
+// site.
+func (t *TabApi) QuerySubscriptions(opts *QueryOptions) (s []model.Subscription, page model.Pagination, err error) {
+	url := fmt.Sprintf("%s/subscriptions%s", t.getSiteUrl(), opts.query())
+	tResponse, err := t.send("QuerySubscriptions", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, page, err
+	}
+	if tResponse.Subscriptions != nil {
+		s = tResponse.Subscriptions.Subscription
+	}
+
+	return s, tResponse.Pagination, nil
+}
+
+// QuerySubscription returns a single subscription.
+func (t *TabApi) QuerySubscription(id string) (s *model.Subscription, err error) {
+	url := fmt.Sprintf("%s/subscriptions/%s", t.getSiteUrl(), id)
+	tResponse, err := t.send("QuerySubscription", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Subscription, nil
+}
+
+// UpdateSubscription changes the subject, message, schedule or attachment
+// settings of the subscription identified by subscription.ID.
+func (t *TabApi) UpdateSubscription(subscription model.Subscription) (s *model.Subscription, err error) {
+	if subscription.ID == "" {
+		return nil, errors.New("Subscription ID is required")
+	}
+	url := fmt.Sprintf("%s/subscriptions/%s", t.getSiteUrl(), subscription.ID)
+	update := subscription
+	update.ID = ""
+	tsRequest := model.TsRequest{Subscription: &update}
+	tResponse, err := t.send("UpdateSubscription", http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Subscription, nil
+}
+
+// DeleteSubscription removes a subscription.
+func (t *TabApi) DeleteSubscription(id string) (err error) {
+	url := fmt.Sprintf("%s/subscriptions/%s", t.getSiteUrl(), id)
+	_, err = t.send("DeleteSubscription", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"io"
 	"mime"
+	"mime/multipart"
 	"net/http"
+	"net/textproto"
 	"net/url"
 	"strings"
 
@@ -72,7 +74,7 @@ func (t *TabApi) Signin(username, password, contentUrl, impersonateUser string)
 		}
 	}
 	var tsr model.TsRequest
-	tsr.Credentials = credentials
+	tsr.Credentials = &credentials
 
 	var payload []byte
 	payload, err = getPayload(tsr, t.ContentType)
@@ -101,35 +103,83 @@ func (t *TabApi) Signin(username, password, contentUrl, impersonateUser string)
 	err = putResponse(resp.Body, &tr, contentType)
 	log.WithField("method", "Signin").
 		WithField("id", "unmarshal tr").Debug(tr)
+	if resp.StatusCode != http.StatusOK || tr.Credentials.Site == nil {
+		return newApiError(resp, &tr)
+	}
 	t.c.authToken = tr.Credentials.Token
 	t.SiteID = tr.Credentials.Site.ID
+	if tr.Credentials.Impersonate != nil {
+		t.UserID = tr.Credentials.Impersonate.ID
+	}
 	log.WithField("method", "Signin").
 		WithField("id", "Token").Debug(t.c.authToken)
 
 	return nil
 }
 
+// NewTrustedTicket requests a trusted ticket for a user. A ticket of "-1",
+// returned when the server does not trust this host or does not know the
+// user, is reported as a *TrustedTicketError.
 func (t *TabApi) NewTrustedTicket(ttr model.TrustedTicketRequest) (tt model.TrustedTicket, err error) {
 	purl := fmt.Sprintf("%s/trusted", t.getUrl())
 	data := url.Values{}
 	data.Set("username", ttr.Username)
-	data.Set("target_site", ttr.Targetsite)
+	if ttr.Targetsite != "" {
+		data.Set("target_site", ttr.Targetsite)
+	}
+	if ttr.ClientIP != "" {
+		data.Set("client_ip", ttr.ClientIP)
+	}
 	payload := strings.NewReader(data.Encode())
 	var ctype ContentType = Form
-	resp, err := t.c.PostWithIP(purl, ctype.String(), payload)
+	resp, err := t.c.Post(purl, ctype.String(), payload)
+	if err != nil {
+		return
+	}
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
 		err = errors.New("Failed: " + resp.Status)
 		return
 	}
 	buf := new(bytes.Buffer)
-	io.Copy(buf, resp.Body)
-	tt.Value = buf.String()
+	if _, err = io.Copy(buf, resp.Body); err != nil {
+		return
+	}
 	log.WithField("method", "NewTrustedTicket").
-		Debug("response: ", tt.Value)
+		Debug("response: ", buf.String())
+	value := strings.TrimSpace(buf.String())
+	if value == "-1" {
+		err = &TrustedTicketError{Username: ttr.Username, Site: ttr.Targetsite}
+		return
+	}
+	tt.Value = value
+	tt.Site = ttr.Targetsite
 	return
 }
 
+// TrustedViewUrl returns the URL that redeems tt and opens view, in the form
+// /trusted/<ticket>/t/<site>/views/<workbook>/<view>. The /t/<site> part is
+// left out for the default site.
+func (t *TabApi) TrustedViewUrl(tt model.TrustedTicket, view *model.View) (string, error) {
+	if tt.Value == "" {
+		return "", errors.New("Trusted ticket is required")
+	}
+	if view == nil {
+		return "", errors.New("View is required")
+	}
+	// a view's content URL is <workbook>/sheets/<view>
+	parts := strings.Split(view.ContentUrl, "/")
+	if len(parts) != 3 {
+		return "", fmt.Errorf("View %s has no content URL", view.ID)
+	}
+	u := fmt.Sprintf("%s/trusted/%s", t.getUrl(), url.PathEscape(tt.Value))
+	if tt.Site != "" {
+		u += "/t/" + url.PathEscape(tt.Site)
+	}
+
+	return fmt.Sprintf("%s/views/%s/%s", u, parts[0], parts[2]), nil
+}
+
 func (t *TabApi) ServerInfo() (si *model.ServerInfo, err error) {
 	//TODO: figure out how to use the apiversion instead of hard coding
 	url := fmt.Sprintf("%s/api/%s/serverinfo", t.getUrl(), DefaultApiVer)
@@ -290,7 +340,7 @@ func (t *TabApi) QuerySites() (w []model.SiteType, err error) {
 	if err != nil {
 		return nil, err
 	}
-	log.WithField("method", "QuerySites").Debugf("ResponseStruct: %s", tResponse)
+	log.WithField("method", "QuerySites").Debugf("ResponseStruct: %v", tResponse)
 	if x, err := xml.Marshal(tResponse); err != nil {
 		log.WithField("method", "QuerySites").
 			Debugf("ServerInfoResponse - XML: %s", x)
@@ -311,6 +361,172 @@ func (t *TabApi) getUrl() string {
 	return url
 }
 
+// getSiteUrl returns the REST endpoint of the site the session is signed in to.
+func (t *TabApi) getSiteUrl() string {
+	return fmt.Sprintf("%s/api/%s/sites/%s", t.getUrl(), t.ApiVersion, t.SiteID)
+}
+
+// send issues an authenticated REST call and decodes the tsResponse envelope.
+// tsr is serialized as the request body when it is not nil. A response status
+// other than expect is returned as an *ApiError built from the error element
+// Tableau includes in the body.
+func (t *TabApi) send(caller, method, url string, tsr *model.TsRequest, expect int) (tResponse *model.TsResponse, err error) {
+	var request interface{}
+	if tsr != nil {
+		request = tsr
+	}
+	return t.sendRequest(caller, method, url, request, expect)
+}
+
+// sendRequest is send for request bodies other than a TsRequest.
+func (t *TabApi) sendRequest(caller, method, url string, request interface{}, expect int) (tResponse *model.TsResponse, err error) {
+	var body io.Reader
+	if request != nil {
+		var payload []byte
+		payload, err = getPayload(request, t.ContentType)
+		if err != nil {
+			return nil, err
+		}
+		log.WithField("method", caller).Debug("payload: ", string(payload))
+		body = bytes.NewBuffer(payload)
+	}
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", t.ContentType.String())
+	}
+	log.WithField("method", caller).Debugf("%s %s", method, url)
+	r, err := t.c.Do(req)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	tResponse, err = decodeResponse(r)
+	if r.StatusCode != expect {
+		return nil, newApiError(r, tResponse)
+	}
+	if err != nil {
+		return nil, err
+	}
+	log.WithField("method", caller).Debugf("response: %v", tResponse)
+
+	return tResponse, nil
+}
+
+// download streams the file served at url into w and returns the file name
+// from the Content-Disposition header.
+func (t *TabApi) download(caller, url string, w io.Writer) (filename string, err error) {
+	log.WithField("method", caller).Debugf("GET %s", url)
+	r, err := t.c.Get(url)
+	if err != nil {
+		log.Error(err)
+		return "", err
+	}
+	defer r.Body.Close()
+	if r.StatusCode != http.StatusOK {
+		tResponse, _ := decodeResponse(r)
+		return "", newApiError(r, tResponse)
+	}
+	filename = dispositionFilename(r.Header.Get("Content-Disposition"))
+	_, err = io.Copy(w, r.Body)
+
+	return filename, err
+}
+
+// dispositionFilename returns the filename parameter of a Content-Disposition
+// header. Tableau sends only the parameters, as in
+// name="tableau_workbook"; filename="Superstore.twbx", so a missing
+// disposition type is filled in before the header is parsed.
+func dispositionFilename(disposition string) string {
+	if i := strings.IndexAny(disposition, ";="); i >= 0 && disposition[i] == '=' {
+		disposition = "attachment; " + disposition
+	}
+	_, params, err := mime.ParseMediaType(disposition)
+	if err != nil {
+		return ""
+	}
+
+	return params["filename"]
+}
+
+// publish uploads a file in a single multipart/mixed request. The request
+// payload describing the content goes in the request_payload part and the
+// file itself in the part called partName.
+func (t *TabApi) publish(caller, url string, tsr *model.TsRequest, partName, filename string, file io.Reader) (tResponse *model.TsResponse, err error) {
+	payload, err := getPayload(tsr, t.ContentType)
+	if err != nil {
+		return nil, err
+	}
+	log.WithField("method", caller).Debug("payload: ", string(payload))
+
+	body := new(bytes.Buffer)
+	mw := multipart.NewWriter(body)
+	h := textproto.MIMEHeader{}
+	h.Set("Content-Disposition", `name="request_payload"`)
+	h.Set("Content-Type", t.ContentType.String())
+	part, err := mw.CreatePart(h)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = part.Write(payload); err != nil {
+		return nil, err
+	}
+	h = textproto.MIMEHeader{}
+	h.Set("Content-Disposition", fmt.Sprintf(`name=%q; filename=%q`, partName, filename))
+	h.Set("Content-Type", "application/octet-stream")
+	part, err = mw.CreatePart(h)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = io.Copy(part, file); err != nil {
+		return nil, err
+	}
+	if err = mw.Close(); err != nil {
+		return nil, err
+	}
+
+	log.WithField("method", caller).Debugf("POST %s", url)
+	r, err := t.c.Post(url, "multipart/mixed; boundary="+mw.Boundary(), body)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	defer r.Body.Close()
+	tResponse, err = decodeResponse(r)
+	if r.StatusCode != http.StatusCreated {
+		return nil, newApiError(r, tResponse)
+	}
+
+	return tResponse, err
+}
+
+// decodeResponse unmarshals the body of r into a TsResponse. Responses without
+// a body, such as those to a DELETE, decode to an empty TsResponse.
+func decodeResponse(r *http.Response) (*model.TsResponse, error) {
+	var tResponse model.TsResponse
+	if r.StatusCode == http.StatusNoContent || r.ContentLength == 0 {
+		return &tResponse, nil
+	}
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return &tResponse, err
+	}
+	contentType, err := ContentTypeString(mediaType)
+	if err != nil {
+		return &tResponse, err
+	}
+	err = putResponse(r.Body, &tResponse, contentType)
+	if err == io.EOF {
+		err = nil
+	}
+
+	return &tResponse, err
+}
+
 // getPayload is a utility function to convert a Go struct into a serialized
 // form for a HTTP POST.
 func getPayload(thingToEncode interface{}, contentType ContentType) (payload []byte, err error) {
@@ -337,7 +553,7 @@ func (t *TabApi) CreateSite(site model.SiteType) (st *model.SiteType, err error)
 	url := fmt.Sprintf("%s/api/%s/sites", t.getUrl(), t.ApiVersion)
 	log.WithField("method", "CreateSite").Debug("url: ", string(url))
 	var tsRequest model.TsRequest
-	tsRequest.Site = site
+	tsRequest.Site = &site
 
 	var payload []byte
 	payload, err = getPayload(tsRequest, t.c.acceptType)
@@ -0,0 +1,86 @@
+package gotabgo
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const testSiteID = "site-1"
+
+// newTestApi returns a TabApi signed in to testSiteID on a stub server that
+// serves every request with handler.
+func newTestApi(t *testing.T, handler http.HandlerFunc) *TabApi {
+	t.Helper()
+	s := httptest.NewServer(handler)
+	t.Cleanup(s.Close)
+	api, err := NewTabApi(strings.TrimPrefix(s.URL, "http://"), "3.18", false, Xml)
+	if err != nil {
+		t.Fatal(err)
+	}
+	api.SiteID = testSiteID
+
+	return api
+}
+
+// writeXml writes body wrapped in a tsResponse element.
+func writeXml(w http.ResponseWriter, body string) {
+	w.Header().Set("Content-Type", Xml.String())
+	fmt.Fprintf(w, `<tsResponse xmlns="http://tableau.com/api">%s</tsResponse>`, body)
+}
+
+// endpointTest describes one call against a stub server that answers with
+// status and resp.
+type endpointTest struct {
+	name   string
+	status int
+	resp   string
+	// call makes the request and summarises the decoded result for want.
+	call   func(api *TabApi) (string, error)
+	method string
+	// url is the path and query of the request, relative to the site URL
+	// unless it starts with "/".
+	url string
+	// payload is a fragment the request body must contain.
+	payload string
+	want    string
+}
+
+func runEndpointTests(t *testing.T, tests []endpointTest) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var method, url, body string
+			api := newTestApi(t, func(w http.ResponseWriter, r *http.Request) {
+				b, _ := ioutil.ReadAll(r.Body)
+				method, url, body = r.Method, r.URL.RequestURI(), string(b)
+				if tt.status == http.StatusNoContent {
+					w.WriteHeader(tt.status)
+					return
+				}
+				w.Header().Set("Content-Type", Xml.String())
+				w.WriteHeader(tt.status)
+				fmt.Fprintf(w, `<tsResponse xmlns="http://tableau.com/api">%s</tsResponse>`, tt.resp)
+			})
+			got, err := tt.call(api)
+			if err != nil {
+				t.Fatal(err)
+			}
+			wantUrl := tt.url
+			if !strings.HasPrefix(wantUrl, "/") {
+				wantUrl = "/api/3.18/sites/" + testSiteID + "/" + wantUrl
+			}
+			if method != tt.method || url != wantUrl {
+				t.Errorf("request = %s %s, want %s %s", method, url, tt.method, wantUrl)
+			}
+			if !strings.Contains(body, tt.payload) {
+				t.Errorf("request body %s does not contain %s", body, tt.payload)
+			}
+			if got != tt.want {
+				t.Errorf("result = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
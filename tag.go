@@ -0,0 +1,81 @@
+package gotabgo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+// AddTags adds tags to a workbook, view or data source and returns the full
+// set of tags on it.
+func (t *TabApi) AddTags(resource model.Resource, id string, labels ...string) (tags []model.Tag, err error) {
+	if len(labels) == 0 {
+		return nil, errors.New("At least one tag is required")
+	}
+	url := fmt.Sprintf("%s/%s/%s/tags", t.getSiteUrl(), resource, id)
+	var add model.Tags
+	for _, l := range labels {
+		add.Tag = append(add.Tag, model.Tag{Label: l})
+	}
+	tsRequest := model.TsRequest{Tags: &add}
+	tResponse, err := t.send("AddTags", http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	if tResponse.Tags != nil {
+		tags = tResponse.Tags.Tag
+	}
+
+	return tags, nil
+}
+
+// DeleteTag removes a tag from a workbook, view or data source.
+func (t *TabApi) DeleteTag(resource model.Resource, id, label string) (err error) {
+	u := fmt.Sprintf("%s/%s/%s/tags/%s", t.getSiteUrl(), resource, id, url.PathEscape(label))
+	_, err = t.send("DeleteTag", http.MethodDelete, u, nil, http.StatusNoContent)
+	return
+}
+
+// AddFavorite adds a project, workbook, view or data source to a user's
+// favorites and returns the user's updated favorites.
+func (t *TabApi) AddFavorite(userID string, favorite model.Favorite) (f []model.Favorite, err error) {
+	if favorite.Label == "" {
+		return nil, errors.New("Favorite label is required")
+	}
+	url := fmt.Sprintf("%s/favorites/%s", t.getSiteUrl(), userID)
+	tsRequest := model.TsRequest{Favorite: &favorite}
+	tResponse, err := t.send("AddFavorite", http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	if tResponse.Favorites != nil {
+		f = tResponse.Favorites.Favorite
+	}
+
+	return f, nil
+}
+
+// DeleteFavorite removes a project, workbook, view or data source from a
+// user's favorites.
+func (t *TabApi) DeleteFavorite(userID string, resource model.Resource, id string) (err error) {
+	url := fmt.Sprintf("%s/favorites/%s/%s/%s", t.getSiteUrl(), userID, resource, id)
+	_, err = t.send("DeleteFavorite", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
+
+// GetFavoritesForUser returns the favorites list of a user.
+func (t *TabApi) GetFavoritesForUser(userID string) (f []model.Favorite, err error) {
+	url := fmt.Sprintf("%s/favorites/%s", t.getSiteUrl(), userID)
+	tResponse, err := t.send("GetFavoritesForUser", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	if tResponse.Favorites != nil {
+		f = tResponse.Favorites.Favorite
+	}
+
+	return f, nil
+}
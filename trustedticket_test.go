@@ -0,0 +1,73 @@
+package gotabgo
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+func TestTrustedViewUrl(t *testing.T) {
+	api, _ := NewTabApi("tableau.example.com", "3.9", true, Xml)
+	view := &model.View{ID: "v1", ContentUrl: "Superstore/sheets/Overview"}
+	tests := []struct {
+		name string
+		site string
+		want string
+	}{
+		{"default site", "", "https://tableau.example.com/trusted/abc==:xyz/views/Superstore/Overview"},
+		{"named site", "finance", "https://tableau.example.com/trusted/abc==:xyz/t/finance/views/Superstore/Overview"},
+	}
+	for _, tt := range tests {
+		got, err := api.TrustedViewUrl(model.TrustedTicket{Value: "abc==:xyz", Site: tt.site}, view)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: url = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	ticket := model.TrustedTicket{Value: "abc"}
+	if _, err := api.TrustedViewUrl(ticket, nil); err == nil {
+		t.Error("expected an error for a nil view")
+	}
+	if _, err := api.TrustedViewUrl(ticket, &model.View{ID: "v1"}); err == nil {
+		t.Error("expected an error for a view without a content URL")
+	}
+	if _, err := api.TrustedViewUrl(model.TrustedTicket{}, view); err == nil {
+		t.Error("expected an error for an empty ticket")
+	}
+}
+
+func TestNewTrustedTicket(t *testing.T) {
+	var form string
+	ticket := "abc==:xyz\n"
+	api := newTestApi(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		form = string(b)
+		w.Write([]byte(ticket))
+	})
+
+	tt, err := api.NewTrustedTicket(model.TrustedTicketRequest{Username: "ann", Targetsite: "finance", ClientIP: "10.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tt.Value != "abc==:xyz" || tt.Site != "finance" {
+		t.Errorf("ticket = %+v", tt)
+	}
+	if want := "client_ip=10.0.0.1&target_site=finance&username=ann"; form != want {
+		t.Errorf("form = %q, want %q", form, want)
+	}
+
+	ticket = "-1"
+	_, err = api.NewTrustedTicket(model.TrustedTicketRequest{Username: "ann"})
+	if te, ok := err.(*TrustedTicketError); !ok || te.Username != "ann" {
+		t.Errorf("err = %v, want *TrustedTicketError", err)
+	}
+	if form != "username=ann" {
+		t.Errorf("form = %q, want only the username for the default site", form)
+	}
+}
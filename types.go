@@ -3,6 +3,9 @@ package gotabgo
 import (
 	"encoding/xml"
 	"errors"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/groundfoundation/gotabgo/model"
 )
@@ -31,12 +34,14 @@ func ContentTypeString(s string) (c ContentType, e error) {
 }
 
 type TabApi struct {
-	UseTLS      bool
-	Server      string
-	ApiVersion  string
-	SiteID      string
-	ContentType ContentType
-	c           *httpClient
+	UseTLS           bool
+	Server           string
+	ApiVersion       string
+	SiteID           string
+	UserID           string
+	ContentType      ContentType
+	c                *httpClient
+	serverApiVersion string
 }
 
 type TsResponse struct {
@@ -45,3 +50,53 @@ type TsResponse struct {
 	ServerInfo  model.ServerInfo  `json:"serverInfo" xml:"serverInfo"`
 	Credentials model.Credentials `json:"credentials" xml:"credentials"`
 }
+
+// QueryOptions pages, filters and sorts the results of a list endpoint.
+// Filter and Sort take expressions in the REST API syntax, for example
+// "name:eq:Finance" or "createdAt:desc".
+type QueryOptions struct {
+	PageSize   int
+	PageNumber int
+	Filter     []string
+	Sort       []string
+	Fields     []string
+}
+
+// query encodes the options as a URL query string, including the leading '?'.
+func (o *QueryOptions) query() string {
+	if o == nil {
+		return ""
+	}
+	v := url.Values{}
+	if o.PageSize > 0 {
+		v.Set("pageSize", strconv.Itoa(o.PageSize))
+	}
+	if o.PageNumber > 0 {
+		v.Set("pageNumber", strconv.Itoa(o.PageNumber))
+	}
+	if len(o.Filter) > 0 {
+		v.Set("filter", strings.Join(o.Filter, ","))
+	}
+	if len(o.Sort) > 0 {
+		v.Set("sort", strings.Join(o.Sort, ","))
+	}
+	if len(o.Fields) > 0 {
+		v.Set("fields", strings.Join(o.Fields, ","))
+	}
+	if len(v) == 0 {
+		return ""
+	}
+
+	return "?" + v.Encode()
+}
+
+// nextPage returns a copy of o advanced to the page after p.
+func (o *QueryOptions) nextPage(p model.Pagination) *QueryOptions {
+	var next QueryOptions
+	if o != nil {
+		next = *o
+	}
+	next.PageNumber = p.PageNumber + 1
+
+	return &next
+}
@@ -0,0 +1,105 @@
+package gotabgo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+// AddUserToSite adds a user to the current site. Name and SiteRole are
+// required, AuthSetting is optional.
+func (t *TabApi) AddUserToSite(user model.User) (u *model.User, err error) {
+	if user.Name == "" || user.SiteRole == "" {
+		return nil, errors.New("User name and site role are required")
+	}
+	url := fmt.Sprintf("%s/users", t.getSiteUrl())
+	add := model.User{
+		Name:        user.Name,
+		SiteRole:    user.SiteRole,
+		AuthSetting: user.AuthSetting,
+	}
+	tsRequest := model.TsRequest{User: &add}
+	tResponse, err := t.send("AddUserToSite", http.MethodPost, url, &tsRequest, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.User, nil
+}
+
+// UpdateUser changes the site role, full name, email, password or
+// authentication type of the user identified by user.ID. Empty fields are
+// left unchanged.
+func (t *TabApi) UpdateUser(user model.User) (u *model.User, err error) {
+	if user.ID == "" {
+		return nil, errors.New("User ID is required")
+	}
+	url := fmt.Sprintf("%s/users/%s", t.getSiteUrl(), user.ID)
+	update := model.User{
+		FullName:    user.FullName,
+		Email:       user.Email,
+		Password:    user.Password,
+		SiteRole:    user.SiteRole,
+		AuthSetting: user.AuthSetting,
+	}
+	tsRequest := model.TsRequest{User: &update}
+	tResponse, err := t.send("UpdateUser", http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.User, nil
+}
+
+// RemoveUserFromSite removes a user from the current site. When mapAssetsTo is
+// set, ownership of the user's content is transferred to that user ID first.
+func (t *TabApi) RemoveUserFromSite(id, mapAssetsTo string) (err error) {
+	url := fmt.Sprintf("%s/users/%s", t.getSiteUrl(), id)
+	if mapAssetsTo != "" {
+		url += "?mapAssetsTo=" + mapAssetsTo
+	}
+	_, err = t.send("RemoveUserFromSite", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
+
+// GetUsersOnSite returns one page of the users on the current site.
+func (t *TabApi) GetUsersOnSite(opts *QueryOptions) (u []model.User, page model.Pagination, err error) {
+	url := fmt.Sprintf("%s/users%s", t.getSiteUrl(), opts.query())
+	tResponse, err := t.send("GetUsersOnSite", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, page, err
+	}
+
+	return tResponse.Users.User, tResponse.Pagination, nil
+}
+
+// GetAllUsersOnSite walks every page of GetUsersOnSite.
+func (t *TabApi) GetAllUsersOnSite(opts *QueryOptions) (u []model.User, err error) {
+	for {
+		users, page, err := t.GetUsersOnSite(opts)
+		if err != nil {
+			return nil, err
+		}
+		u = append(u, users...)
+		if len(users) == 0 || !page.HasMore() {
+			return u, nil
+		}
+		opts = opts.nextPage(page)
+	}
+}
+
+// QueryUser returns the user with the given ID.
+func (t *TabApi) QueryUser(id string) (u *model.User, err error) {
+	url := fmt.Sprintf("%s/users/%s", t.getSiteUrl(), id)
+	tResponse, err := t.send("QueryUser", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	if tResponse.User == nil {
+		return nil, errors.New("User Not Found on site")
+	}
+
+	return tResponse.User, nil
+}
@@ -0,0 +1,70 @@
+package gotabgo
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+func TestUserEndpoints(t *testing.T) {
+	const user = `<user id="u1" name="ann" siteRole="Viewer"/>`
+	runEndpointTests(t, []endpointTest{
+		{
+			name: "AddUserToSite", status: http.StatusCreated, resp: user,
+			call: func(api *TabApi) (string, error) {
+				u, err := api.AddUserToSite(model.User{Name: "ann", SiteRole: model.SiteRoleViewer, FullName: "ignored"})
+				if err != nil {
+					return "", err
+				}
+				return u.ID, nil
+			},
+			method: http.MethodPost, url: "users",
+			payload: `<user name="ann" siteRole="Viewer"></user>`, want: "u1",
+		},
+		{
+			name: "UpdateUser", status: http.StatusOK, resp: user,
+			call: func(api *TabApi) (string, error) {
+				u, err := api.UpdateUser(model.User{ID: "u1", Email: "ann@example.com"})
+				if err != nil {
+					return "", err
+				}
+				return u.SiteRole, nil
+			},
+			method: http.MethodPut, url: "users/u1",
+			payload: `<user email="ann@example.com"></user>`, want: "Viewer",
+		},
+		{
+			name: "RemoveUserFromSite", status: http.StatusNoContent,
+			call: func(api *TabApi) (string, error) {
+				return "", api.RemoveUserFromSite("u1", "u2")
+			},
+			method: http.MethodDelete, url: "users/u1?mapAssetsTo=u2",
+		},
+		{
+			name: "GetUsersOnSite", status: http.StatusOK,
+			resp: `<pagination pageNumber="1" pageSize="2" totalAvailable="3"/><users>` + user + `<user id="u2"/></users>`,
+			call: func(api *TabApi) (string, error) {
+				u, page, err := api.GetUsersOnSite(&QueryOptions{PageSize: 2, Filter: []string{"siteRole:eq:Viewer"}})
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprint(len(u), page.TotalAvailable, page.HasMore()), nil
+			},
+			method: http.MethodGet, url: "users?filter=siteRole%3Aeq%3AViewer&pageSize=2",
+			want: "2 3 true",
+		},
+		{
+			name: "QueryUser", status: http.StatusOK, resp: user,
+			call: func(api *TabApi) (string, error) {
+				u, err := api.QueryUser("u1")
+				if err != nil {
+					return "", err
+				}
+				return u.Name, nil
+			},
+			method: http.MethodGet, url: "users/u1", want: "ann",
+		},
+	})
+}
@@ -0,0 +1,55 @@
+package gotabgo
+
+import (
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// requireApiVersion returns a *VersionError when the endpoint needs REST API
+// min and either t.ApiVersion, which request URLs are built with, or the
+// server's REST API version, as reported by ServerInfo, is older. The server
+// version is looked up once and cached on t.
+func (t *TabApi) requireApiVersion(feature, min string) error {
+	if compareVersions(t.ApiVersion, min) < 0 {
+		return &VersionError{Feature: feature, Required: min, Client: t.ApiVersion}
+	}
+	if t.serverApiVersion == "" {
+		si, err := t.ServerInfo()
+		if err != nil {
+			return err
+		}
+		t.serverApiVersion = si.RestApiVersion
+		log.WithField("method", "requireApiVersion").Debug("server api version: ", t.serverApiVersion)
+	}
+	if compareVersions(t.serverApiVersion, min) < 0 {
+		return &VersionError{Feature: feature, Required: min, Server: t.serverApiVersion}
+	}
+
+	return nil
+}
+
+// compareVersions compares dotted version strings such as "3.19" numerically,
+// returning -1, 0 or 1.
+func compareVersions(a, b string) int {
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if x < y {
+			return -1
+		}
+		if x > y {
+			return 1
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,62 @@
+package gotabgo
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"3.9", "3.18", -1},
+		{"3.19", "3.19", 0},
+		{"3.20", "3.19", 1},
+		{"3", "3.0", 0},
+		{"2.4", "3.1", -1},
+	}
+	for _, tt := range tests {
+		if got := compareVersions(tt.a, tt.b); got != tt.want {
+			t.Errorf("compareVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestRequireApiVersion(t *testing.T) {
+	serverInfoCalls := 0
+	api := newTestApi(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/serverinfo") {
+			serverInfoCalls++
+			writeXml(w, `<serverInfo><restApiVersion>3.18</restApiVersion></serverInfo>`)
+			return
+		}
+		writeXml(w, `<identityPools/>`)
+	})
+
+	api.ApiVersion = "3.9"
+	err := api.requireApiVersion("QueryVirtualConnections", "3.18")
+	if ve, ok := err.(*VersionError); !ok || ve.Client != "3.9" {
+		t.Fatalf("err = %v, want client VersionError", err)
+	}
+	if !strings.Contains(err.Error(), "set ApiVersion to 3.18") {
+		t.Errorf("Error() = %q", err.Error())
+	}
+	if serverInfoCalls != 0 {
+		t.Errorf("server was asked for its version before the client version was checked")
+	}
+
+	api.ApiVersion = "3.19"
+	_, err = api.QueryIdentityPools()
+	if ve, ok := err.(*VersionError); !ok || ve.Server != "3.18" {
+		t.Fatalf("err = %v, want server VersionError", err)
+	}
+	api.ApiVersion = "3.18"
+	if err = api.requireApiVersion("QueryVirtualConnections", "3.18"); err != nil {
+		t.Error(err)
+	}
+	if serverInfoCalls != 1 {
+		t.Errorf("ServerInfo called %d times, want 1", serverInfoCalls)
+	}
+}
@@ -0,0 +1,114 @@
+package gotabgo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+const (
+	virtualConnectionApiVer        = "3.18"
+	virtualConnectionPublishApiVer = "3.23"
+)
+
+// QueryVirtualConnections returns one page of the virtual connections on the
+// current site.
+func (t *TabApi) QueryVirtualConnections(opts *QueryOptions) (vc []model.VirtualConnection, page model.Pagination, err error) {
+	if err = t.requireApiVersion("QueryVirtualConnections", virtualConnectionApiVer); err != nil {
+		return nil, page, err
+	}
+	url := fmt.Sprintf("%s/virtualconnections%s", t.getSiteUrl(), opts.query())
+	tResponse, err := t.send("QueryVirtualConnections", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, page, err
+	}
+	if tResponse.VirtualConnections != nil {
+		vc = tResponse.VirtualConnections.VirtualConnection
+	}
+
+	return vc, tResponse.Pagination, nil
+}
+
+// PublishVirtualConnection publishes a virtual connection from its JSON
+// definition in vc.Content. vc.Project must name the target project.
+func (t *TabApi) PublishVirtualConnection(vc model.VirtualConnection, overwrite bool) (v *model.VirtualConnection, err error) {
+	if err = t.requireApiVersion("PublishVirtualConnection", virtualConnectionPublishApiVer); err != nil {
+		return nil, err
+	}
+	if vc.Project == nil || vc.Project.ID == "" {
+		return nil, errors.New("Project ID is required")
+	}
+	url := fmt.Sprintf("%s/virtualconnections?overwrite=%t", t.getSiteUrl(), overwrite)
+	tsRequest := model.TsRequest{VirtualConnection: &vc}
+	tResponse, err := t.send("PublishVirtualConnection", http.MethodPost, url, &tsRequest, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.VirtualConnection, nil
+}
+
+// UpdateVirtualConnection changes the name, description, certification,
+// project or owner of the virtual connection identified by vc.ID.
+func (t *TabApi) UpdateVirtualConnection(vc model.VirtualConnection) (v *model.VirtualConnection, err error) {
+	if err = t.requireApiVersion("UpdateVirtualConnection", virtualConnectionPublishApiVer); err != nil {
+		return nil, err
+	}
+	if vc.ID == "" {
+		return nil, errors.New("Virtual Connection ID is required")
+	}
+	url := fmt.Sprintf("%s/virtualconnections/%s", t.getSiteUrl(), vc.ID)
+	update := vc
+	update.ID = ""
+	tsRequest := model.TsRequest{VirtualConnection: &update}
+	tResponse, err := t.send("UpdateVirtualConnection", http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.VirtualConnection, nil
+}
+
+// QueryVirtualConnectionConnections lists the database connections of a
+// virtual connection.
+func (t *TabApi) QueryVirtualConnectionConnections(id string, opts *QueryOptions) (c []model.Connection, page model.Pagination, err error) {
+	if err = t.requireApiVersion("QueryVirtualConnectionConnections", virtualConnectionApiVer); err != nil {
+		return nil, page, err
+	}
+	url := fmt.Sprintf("%s/virtualconnections/%s/connections%s", t.getSiteUrl(), id, opts.query())
+	tResponse, err := t.send("QueryVirtualConnectionConnections", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, page, err
+	}
+	if tResponse.VirtualConnectionConnections != nil {
+		c = tResponse.VirtualConnectionConnections.Connection
+	}
+
+	return c, tResponse.Pagination, nil
+}
+
+// UpdateVirtualConnectionConnection changes the server, port or credentials of
+// one database connection of a virtual connection. Set ServerAddress and
+// ServerPort; the DBClass, Server and Port returned by
+// QueryVirtualConnectionConnections are left out of the request.
+func (t *TabApi) UpdateVirtualConnectionConnection(id string, connection model.Connection) (c *model.Connection, err error) {
+	if err = t.requireApiVersion("UpdateVirtualConnectionConnection", virtualConnectionApiVer); err != nil {
+		return nil, err
+	}
+	if connection.ID == "" {
+		return nil, errors.New("Connection ID is required")
+	}
+	url := fmt.Sprintf("%s/virtualconnections/%s/connections/%s/modify", t.getSiteUrl(), id, connection.ID)
+	update := connection
+	update.ID = ""
+	update.DBClass, update.Server, update.Port = "", "", ""
+	tsRequest := model.TsRequest{Connection: &update}
+	tResponse, err := t.send("UpdateVirtualConnectionConnection", http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Connection, nil
+}
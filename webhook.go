@@ -0,0 +1,100 @@
+package gotabgo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+// CreateWebhook registers a webhook that posts to url whenever event happens
+// on the current site.
+func (t *TabApi) CreateWebhook(name string, event model.WebhookEvent, url string) (w *model.Webhook, err error) {
+	if name == "" || event == "" || url == "" {
+		return nil, errors.New("Webhook name, event and url are required")
+	}
+	webhook := model.Webhook{
+		Name:   name,
+		Source: event.Source(),
+		Destination: &model.WebhookDestination{
+			HTTP: &model.WebhookDestinationHttp{Method: http.MethodPost, Url: url},
+		},
+	}
+	tsRequest := model.TsRequest{Webhook: &webhook}
+	tResponse, err := t.send("CreateWebhook", http.MethodPost, fmt.Sprintf("%s/webhooks", t.getSiteUrl()), &tsRequest, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Webhook, nil
+}
+
+// ListWebhooks returns the webhooks registered on the current site.
+func (t *TabApi) ListWebhooks() (w []model.Webhook, err error) {
+	url := fmt.Sprintf("%s/webhooks", t.getSiteUrl())
+	tResponse, err := t.send("ListWebhooks", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	if tResponse.Webhooks != nil {
+		w = tResponse.Webhooks.Webhook
+	}
+
+	return w, nil
+}
+
+// GetWebhook returns the webhook with the given ID.
+func (t *TabApi) GetWebhook(id string) (w *model.Webhook, err error) {
+	url := fmt.Sprintf("%s/webhooks/%s", t.getSiteUrl(), id)
+	tResponse, err := t.send("GetWebhook", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Webhook, nil
+}
+
+// TestWebhook has the server send a test payload to the webhook destination
+// and reports how the destination responded.
+func (t *TabApi) TestWebhook(id string) (r *model.WebhookTestResult, err error) {
+	url := fmt.Sprintf("%s/webhooks/%s/test", t.getSiteUrl(), id)
+	tResponse, err := t.send("TestWebhook", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.WebhookTestResult, nil
+}
+
+// UpdateWebhook changes the name, event, destination or enabled state of the
+// webhook identified by webhook.ID. Setting Event fills in Source.
+func (t *TabApi) UpdateWebhook(webhook model.Webhook) (w *model.Webhook, err error) {
+	if webhook.ID == "" {
+		return nil, errors.New("Webhook ID is required")
+	}
+	url := fmt.Sprintf("%s/webhooks/%s", t.getSiteUrl(), webhook.ID)
+	update := model.Webhook{
+		Name:        webhook.Name,
+		IsEnabled:   webhook.IsEnabled,
+		Source:      webhook.Source,
+		Destination: webhook.Destination,
+	}
+	if webhook.Event != "" {
+		update.Source = webhook.Event.Source()
+	}
+	tsRequest := model.TsRequest{Webhook: &update}
+	tResponse, err := t.send("UpdateWebhook", http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Webhook, nil
+}
+
+// DeleteWebhook removes a webhook from the current site.
+func (t *TabApi) DeleteWebhook(id string) (err error) {
+	url := fmt.Sprintf("%s/webhooks/%s", t.getSiteUrl(), id)
+	_, err = t.send("DeleteWebhook", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
@@ -0,0 +1,148 @@
+// Package webhook receives the payloads Tableau Server posts to registered
+// webhooks and dispatches them to callbacks.
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/groundfoundation/gotabgo"
+	"github.com/groundfoundation/gotabgo/model"
+	log "github.com/sirupsen/logrus"
+)
+
+// Resource types reported in webhook payloads
+const (
+	ResourceWorkbook   = "WORKBOOK"
+	ResourceDatasource = "DATASOURCE"
+	ResourceView       = "VIEW"
+	ResourceUser       = "USER"
+	ResourceLabel      = "LABEL"
+)
+
+// maxPayloadBytes bounds the size of a webhook payload a Handler will read.
+// Tableau's payloads are a few hundred bytes.
+const maxPayloadBytes = 1 << 20
+
+// Event is a webhook payload sent by Tableau Server
+type Event struct {
+	Resource     string             `json:"resource"`
+	EventType    model.WebhookEvent `json:"event_type"`
+	ResourceName string             `json:"resource_name"`
+	ResourceLuid string             `json:"resource_luid"`
+	SiteLuid     string             `json:"site_luid"`
+	CreatedAt    string             `json:"created_at"`
+
+	// Workbook and Datasource are filled in by a Handler with an Api when the
+	// event is about a workbook or data source that still exists.
+	Workbook   *model.Workbook   `json:"-"`
+	Datasource *model.Datasource `json:"-"`
+}
+
+// Callback is called with each event a Handler receives. A callback that
+// returns an error makes the handler answer 500.
+type Callback func(e *Event) error
+
+// Handler is an http.Handler for webhook destinations.
+type Handler struct {
+	// Api, when set, is used to look up the workbook or data source an event
+	// is about. Its session must be signed in to the site the webhooks are
+	// registered on.
+	Api *gotabgo.TabApi
+
+	mu        sync.RWMutex
+	callbacks map[model.WebhookEvent][]Callback
+	all       []Callback
+}
+
+// NewHandler returns a Handler that enriches events through api. api may be
+// nil.
+func NewHandler(api *gotabgo.TabApi) *Handler {
+	return &Handler{
+		Api:       api,
+		callbacks: make(map[model.WebhookEvent][]Callback),
+	}
+}
+
+// On registers cb for one event type.
+func (h *Handler) On(event model.WebhookEvent, cb Callback) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.callbacks == nil {
+		h.callbacks = make(map[model.WebhookEvent][]Callback)
+	}
+	h.callbacks[event] = append(h.callbacks[event], cb)
+}
+
+// OnAny registers cb for every event.
+func (h *Handler) OnAny(cb Callback) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.all = append(h.all, cb)
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxPayloadBytes)
+	e, err := Parse(r)
+	if err != nil {
+		log.WithField("method", "webhook.ServeHTTP").Error(err)
+		http.Error(w, "invalid webhook payload", http.StatusBadRequest)
+		return
+	}
+	log.WithField("method", "webhook.ServeHTTP").Debugf("event: %v", e)
+	h.enrich(e)
+	if err = h.dispatch(e); err != nil {
+		log.WithField("method", "webhook.ServeHTTP").Error(err)
+		http.Error(w, "webhook callback failed", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// Parse decodes the webhook payload in the body of r.
+func Parse(r *http.Request) (*Event, error) {
+	var e Event
+	if err := json.NewDecoder(r.Body).Decode(&e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// dispatch calls the callbacks registered for e, stopping at the first error.
+func (h *Handler) dispatch(e *Event) error {
+	h.mu.RLock()
+	cbs := append(append([]Callback{}, h.callbacks[e.EventType]...), h.all...)
+	h.mu.RUnlock()
+	for _, cb := range cbs {
+		if err := cb(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// enrich looks up the content an event refers to. Lookups are skipped for
+// deleted content and for events from a site the Api is not signed in to;
+// failures are logged and leave the event as it was received.
+func (h *Handler) enrich(e *Event) {
+	if h.Api == nil || e.ResourceLuid == "" || e.SiteLuid != h.Api.SiteID {
+		return
+	}
+	var err error
+	switch {
+	case e.Resource == ResourceWorkbook && e.EventType != model.WorkbookDeleted:
+		e.Workbook, err = h.Api.QueryWorkbook(e.ResourceLuid)
+	case e.Resource == ResourceDatasource && e.EventType != model.DatasourceDeleted:
+		e.Datasource, err = h.Api.QueryDatasource(e.ResourceLuid)
+	}
+	if err != nil {
+		log.WithField("method", "webhook.enrich").
+			Warnf("looking up %s %s: %v", e.Resource, e.ResourceLuid, err)
+	}
+}
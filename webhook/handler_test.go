@@ -0,0 +1,74 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/groundfoundation/gotabgo/model"
+)
+
+const refreshFailed = `{"resource":"WORKBOOK","event_type":"WorkbookRefreshFailed","resource_name":"Sales","resource_luid":"wb-1","site_luid":"site-1","created_at":"2024-01-02T03:04:05Z"}`
+
+func post(h http.Handler, body string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/hook", strings.NewReader(body)))
+	return rec
+}
+
+func TestHandlerDispatch(t *testing.T) {
+	h := NewHandler(nil)
+	var calls []string
+	h.On(model.WorkbookRefreshFailed, func(e *Event) error {
+		calls = append(calls, "failed:"+e.ResourceName)
+		return nil
+	})
+	h.On(model.WorkbookCreated, func(e *Event) error {
+		calls = append(calls, "created")
+		return nil
+	})
+	h.OnAny(func(e *Event) error {
+		calls = append(calls, "any:"+e.Resource)
+		return nil
+	})
+
+	rec := post(h, refreshFailed)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d", rec.Code)
+	}
+	if fmt.Sprint(calls) != "[failed:Sales any:WORKBOOK]" {
+		t.Errorf("callbacks = %v", calls)
+	}
+}
+
+func TestHandlerErrors(t *testing.T) {
+	h := NewHandler(nil)
+	h.OnAny(func(e *Event) error {
+		return errors.New("database password rejected")
+	})
+
+	rec := post(h, refreshFailed)
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d, want 500", rec.Code)
+	}
+	if strings.Contains(rec.Body.String(), "password") {
+		t.Errorf("callback error leaked to caller: %q", rec.Body.String())
+	}
+
+	if rec = post(h, "not json"); rec.Code != http.StatusBadRequest {
+		t.Errorf("status for bad payload = %d, want 400", rec.Code)
+	}
+	big := `{"resource_name":"` + strings.Repeat("x", maxPayloadBytes) + `"}`
+	if rec = post(h, big); rec.Code != http.StatusBadRequest {
+		t.Errorf("status for oversized payload = %d, want 400", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/hook", nil))
+	if rec.Code != http.StatusMethodNotAllowed || rec.Header().Get("Allow") != http.MethodPost {
+		t.Errorf("GET status = %d, Allow = %q", rec.Code, rec.Header().Get("Allow"))
+	}
+}
@@ -0,0 +1,180 @@
+package gotabgo
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/groundfoundation/gotabgo/model"
+	log "github.com/sirupsen/logrus"
+)
+
+// QueryWorkbook returns the workbook with the given ID.
+func (t *TabApi) QueryWorkbook(id string) (w *model.Workbook, err error) {
+	url := fmt.Sprintf("%s/workbooks/%s", t.getSiteUrl(), id)
+	tResponse, err := t.send("QueryWorkbook", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	if tResponse.Workbook == nil {
+		return nil, errors.New("Workbook Not Found on site")
+	}
+
+	return tResponse.Workbook, nil
+}
+
+// PublishWorkbook uploads a .twb or .twbx file as workbook.Name into
+// workbook.Project. Files larger than 64MB must be published in chunks, which
+// this does not do.
+func (t *TabApi) PublishWorkbook(workbook model.Workbook, filename string, file io.Reader, overwrite bool) (w *model.Workbook, err error) {
+	if workbook.Name == "" || workbook.Project == nil {
+		return nil, errors.New("Workbook name and project are required")
+	}
+	ext := strings.TrimPrefix(path.Ext(filename), ".")
+	if ext != "twb" && ext != "twbx" {
+		return nil, fmt.Errorf("Workbook file must be a .twb or .twbx: %s", filename)
+	}
+	url := fmt.Sprintf("%s/workbooks?workbookType=%s&overwrite=%t", t.getSiteUrl(), ext, overwrite)
+	publish := model.Workbook{
+		Name:        workbook.Name,
+		Description: workbook.Description,
+		ShowTabs:    workbook.ShowTabs,
+		Project:     &model.Project{ID: workbook.Project.ID},
+	}
+	tsRequest := model.TsRequest{Workbook: &publish}
+	tResponse, err := t.publish("PublishWorkbook", url, &tsRequest, "tableau_workbook", filename, file)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Workbook, nil
+}
+
+// ListWorkbookRevisions returns one page of the revision history of a
+// workbook. Revision history must be enabled on the site.
+func (t *TabApi) ListWorkbookRevisions(workbookID string, opts *QueryOptions) (r []model.Revision, page model.Pagination, err error) {
+	url := fmt.Sprintf("%s/workbooks/%s/revisions%s", t.getSiteUrl(), workbookID, opts.query())
+	return t.listRevisions("ListWorkbookRevisions", url)
+}
+
+// DownloadWorkbookRevision writes the file of a workbook revision to w and
+// returns its file name.
+func (t *TabApi) DownloadWorkbookRevision(workbookID, revision string, w io.Writer) (filename string, err error) {
+	url := fmt.Sprintf("%s/workbooks/%s/revisions/%s/content", t.getSiteUrl(), workbookID, revision)
+	return t.download("DownloadWorkbookRevision", url, w)
+}
+
+// RemoveWorkbookRevision deletes a revision from the history of a workbook.
+func (t *TabApi) RemoveWorkbookRevision(workbookID, revision string) (err error) {
+	url := fmt.Sprintf("%s/workbooks/%s/revisions/%s", t.getSiteUrl(), workbookID, revision)
+	_, err = t.send("RemoveWorkbookRevision", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
+
+// RestoreWorkbookRevision makes a prior revision the current one by
+// downloading it and publishing it over the workbook.
+func (t *TabApi) RestoreWorkbookRevision(workbookID, revision string) (w *model.Workbook, err error) {
+	current, err := t.QueryWorkbook(workbookID)
+	if err != nil {
+		return nil, err
+	}
+	var file bytes.Buffer
+	filename, err := t.DownloadWorkbookRevision(workbookID, revision, &file)
+	if err != nil {
+		return nil, err
+	}
+	log.WithField("method", "RestoreWorkbookRevision").
+		Debugf("restoring %s revision %s from %s", current.Name, revision, filename)
+
+	return t.PublishWorkbook(*current, filename, &file, true)
+}
+
+func (t *TabApi) listRevisions(caller, url string) (r []model.Revision, page model.Pagination, err error) {
+	tResponse, err := t.send(caller, http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, page, err
+	}
+	if tResponse.Revisions != nil {
+		r = tResponse.Revisions.Revision
+	}
+
+	return r, tResponse.Pagination, nil
+}
+
+// UpdateWorkbook changes the name, tab display, project, owner or data
+// acceleration of the workbook identified by workbook.ID. Set Project or Owner
+// to just an ID to move the workbook or hand it to another user.
+func (t *TabApi) UpdateWorkbook(workbook model.Workbook) (w *model.Workbook, err error) {
+	if workbook.ID == "" {
+		return nil, errors.New("Workbook ID is required")
+	}
+	url := fmt.Sprintf("%s/workbooks/%s", t.getSiteUrl(), workbook.ID)
+	update := model.Workbook{
+		Name:                   workbook.Name,
+		ShowTabs:               workbook.ShowTabs,
+		DataAccelerationConfig: workbook.DataAccelerationConfig,
+	}
+	if workbook.Project != nil {
+		update.Project = &model.Project{ID: workbook.Project.ID}
+	}
+	if workbook.Owner != nil {
+		update.Owner = &model.Owner{ID: workbook.Owner.ID}
+	}
+	tsRequest := model.TsRequest{Workbook: &update}
+	tResponse, err := t.send("UpdateWorkbook", http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Workbook, nil
+}
+
+// DeleteWorkbook removes a workbook and its views.
+func (t *TabApi) DeleteWorkbook(id string) (err error) {
+	url := fmt.Sprintf("%s/workbooks/%s", t.getSiteUrl(), id)
+	_, err = t.send("DeleteWorkbook", http.MethodDelete, url, nil, http.StatusNoContent)
+	return
+}
+
+// QueryWorkbookConnections lists the data connections of a workbook.
+func (t *TabApi) QueryWorkbookConnections(workbookID string) (c []model.Connection, err error) {
+	url := fmt.Sprintf("%s/workbooks/%s/connections", t.getSiteUrl(), workbookID)
+	tResponse, err := t.send("QueryWorkbookConnections", http.MethodGet, url, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	if tResponse.Connections != nil {
+		c = tResponse.Connections.Connection
+	}
+
+	return c, nil
+}
+
+// UpdateWorkbookConnection changes the server address, port, user name,
+// password or embedded password setting of the connection identified by
+// connection.ID.
+func (t *TabApi) UpdateWorkbookConnection(workbookID string, connection model.Connection) (c *model.Connection, err error) {
+	if connection.ID == "" {
+		return nil, errors.New("Connection ID is required")
+	}
+	url := fmt.Sprintf("%s/workbooks/%s/connections/%s", t.getSiteUrl(), workbookID, connection.ID)
+	update := model.Connection{
+		ServerAddress:       connection.ServerAddress,
+		ServerPort:          connection.ServerPort,
+		UserName:            connection.UserName,
+		Password:            connection.Password,
+		EmbedPassword:       connection.EmbedPassword,
+		QueryTaggingEnabled: connection.QueryTaggingEnabled,
+	}
+	tsRequest := model.TsRequest{Connection: &update}
+	tResponse, err := t.send("UpdateWorkbookConnection", http.MethodPut, url, &tsRequest, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return tResponse.Connection, nil
+}
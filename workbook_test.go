@@ -0,0 +1,81 @@
+package gotabgo
+
+import (
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// restoreStub serves a restore of revision 2 of the content at path: the
+// current item, the revision file in Tableau's Content-Disposition format and
+// the publish that overwrites it. published receives the file name and body
+// of the uploaded file.
+func restoreStub(t *testing.T, path, partName, current, filename string, published func(name, body string)) *TabApi {
+	base := "/api/3.18/sites/site-1/" + path
+	return newTestApi(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == base+"/c1":
+			writeXml(w, current)
+		case r.Method == http.MethodGet && r.URL.Path == base+"/c1/revisions/2/content":
+			w.Header().Set("Content-Disposition", `name="`+partName+`"; filename="`+filename+`"`)
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Write([]byte("revision 2"))
+		case r.Method == http.MethodPost && r.URL.Path == base:
+			if r.URL.Query().Get("overwrite") != "true" {
+				t.Errorf("publish query = %s", r.URL.RawQuery)
+			}
+			_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			mr := multipart.NewReader(r.Body, params["boundary"])
+			for {
+				part, err := mr.NextPart()
+				if err != nil {
+					break
+				}
+				_, disp, _ := mime.ParseMediaType("attachment; " + part.Header.Get("Content-Disposition"))
+				if disp["name"] == partName {
+					body, _ := ioutil.ReadAll(part)
+					published(disp["filename"], string(body))
+				}
+			}
+			w.Header().Set("Content-Type", Xml.String())
+			w.WriteHeader(http.StatusCreated)
+			writeXml(w, current)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func TestRestoreWorkbookRevision(t *testing.T) {
+	var name, body string
+	api := restoreStub(t, "workbooks", "tableau_workbook",
+		`<workbook id="c1" name="Superstore"><project id="p1"/></workbook>`,
+		"Superstore.twbx", func(n, b string) { name, body = n, b })
+	wb, err := api.RestoreWorkbookRevision("c1", "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wb.ID != "c1" || name != "Superstore.twbx" || body != "revision 2" {
+		t.Errorf("workbook = %+v, published %q with %q", wb, name, body)
+	}
+}
+
+func TestDownloadWorkbookRevision(t *testing.T) {
+	api := restoreStub(t, "workbooks", "tableau_workbook", "", "Superstore.twbx", nil)
+	var file strings.Builder
+	filename, err := api.DownloadWorkbookRevision("c1", "2", &file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filename != "Superstore.twbx" || file.String() != "revision 2" {
+		t.Errorf("filename = %q, file = %q", filename, file.String())
+	}
+}